@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/flixkit-go/v2/flixkit"
+)
+
+func runGet(c *cli, args []string) error {
+	flags := c.newFlags("get", "<template>")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	template, source, err := c.service.GetTemplate(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	if c.json {
+		if !json.Valid([]byte(template)) {
+			return fmt.Errorf("template from %s is not valid json", source)
+		}
+		return c.writeJSON(struct {
+			Source   string
+			Template json.RawMessage
+		}{source, json.RawMessage(template)})
+	}
+	fmt.Fprintln(c.stdout, strings.TrimRight(template, "\n"))
+	return nil
+}
+
+func runResolve(c *cli, args []string) error {
+	flags := c.newFlags("resolve", "<template>")
+	network := flags.String("network", "mainnet", "network the imports are resolved for")
+	aliases := keyValues{}
+	overrides := keyValues{}
+	flags.Var(aliases, "alias", "`network=template network` the network is resolved as, can be repeated")
+	flags.Var(overrides, "override", "`contract=address` used instead of the template address, can be repeated")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	execution, err := c.service.GetTemplateAndReplaceImportsWithOptions(context.Background(), args[0], *network, flixkit.ResolveOptions{
+		NetworkAliases:    aliases,
+		ContractOverrides: overrides,
+	})
+	if err != nil {
+		return err
+	}
+	if c.json {
+		return c.writeJSON(execution)
+	}
+	fmt.Fprintln(c.stdout, strings.TrimRight(execution.Cadence, "\n"))
+	return nil
+}
+
+func runBindings(c *cli, args []string) error {
+	flags := c.newFlags("bindings", "<template>...")
+	lang := flags.String("lang", "js", "binding language: js, ts, react, py, go, swift, kotlin or a custom binding")
+	out := flags.String("out", "", "file the binding is written to, or the directory of a bundle; stdout when empty")
+	bundle := flags.String("bundle", "", "create a bundle of all templates, single or modules")
+	embed := flags.Bool("embed", false, "inline the template after verifying its id")
+	txResults := flags.Bool("tx-results", false, "add transaction functions returning decoded events")
+	numbers := flags.String("numbers", string(flixkit.NumberString), "type numbers are decoded to: string, bigint or decimal")
+	contracts := keyValues{}
+	flags.Var(contracts, "contract", "`name=file` of dependency contract cadence whose events are decoded, can be repeated")
+	args, err := parse(flags, args, 1, -1)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+
+	if *bundle != "" {
+		mode := flixkit.BundleMode(*bundle)
+		if mode != flixkit.BundleSingleModule && mode != flixkit.BundleModules {
+			return usageError("invalid bundle mode %s, expected single or modules", *bundle)
+		}
+		files, err := c.service.GetTemplatesAndCreateBundle(ctx, args, *lang, *out, mode)
+		if err != nil {
+			return err
+		}
+		return c.writeFiles(*out, files)
+	}
+	if len(args) > 1 {
+		return usageError("bindings of %d templates need -bundle", len(args))
+	}
+
+	opts := flixkit.BindingOptions{
+		Embed:     *embed,
+		Numbers:   flixkit.NumberType(*numbers),
+		TxResults: *txResults,
+	}
+	for name, path := range contracts {
+		code, err := c.files.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		opts.Contracts = mapSet(opts.Contracts, name, string(code))
+	}
+	binding, err := c.service.GetTemplateAndCreateBindingWithOptions(ctx, args[0], *lang, *out, opts)
+	if err != nil {
+		return err
+	}
+	return c.writeFiles("", []flixkit.BindingFile{{Path: *out, Content: binding}})
+}
+
+// writeFiles writes generated files below dir, files are written to stdout when dir and their path are empty
+func (c *cli) writeFiles(dir string, files []flixkit.BindingFile) error {
+	if dir == "" && len(files) == 1 && files[0].Path == "" {
+		if c.json {
+			return c.writeJSON(struct{ Files []flixkit.BindingFile }{files})
+		}
+		fmt.Fprint(c.stdout, files[0].Content)
+		return nil
+	}
+	var written []flixkit.BindingFile
+	for _, file := range files {
+		path := filepath.Join(dir, file.Path)
+		if err := c.files.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return err
+		}
+		if err := c.files.WriteFile(path, []byte(file.Content), 0644); err != nil {
+			return err
+		}
+		written = append(written, flixkit.BindingFile{Path: path})
+	}
+	if c.json {
+		return c.writeJSON(struct{ Files []flixkit.BindingFile }{written})
+	}
+	for _, file := range written {
+		fmt.Fprintln(c.stdout, file.Path)
+	}
+	return nil
+}
+
+func runGenerate(c *cli, args []string) error {
+	flags := c.newFlags("generate", "<cadence file>")
+	flowJSON := flags.String("flow-json", "", "flow.json contract addresses and networks are read from")
+	networks := flags.String("network", "", "comma separated networks of the flow.json dependencies are pinned on, all when empty")
+	preFill := flags.String("pre-fill", "", "partially filled template by name, id, url or file")
+	out := flags.String("out", "", "file the template is written to; stdout when empty")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	code, err := c.files.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+
+	var contracts flixkit.ContractInfos
+	var configs []flixkit.NetworkConfig
+	if *flowJSON != "" {
+		project, err := flixkit.LoadFlowProject(c.files, *flowJSON)
+		if err != nil {
+			return err
+		}
+		var names []string
+		if *networks != "" {
+			names = strings.Split(*networks, ",")
+		}
+		if configs, err = project.NetworkConfigs(names...); err != nil {
+			return err
+		}
+		contracts = project.ContractInfos
+	} else if *networks != "" {
+		return usageError("-network needs -flow-json")
+	}
+
+	template, err := c.service.CreateTemplate(context.Background(), contracts, string(code), *preFill, configs)
+	if err != nil {
+		return err
+	}
+	if *out != "" {
+		if err := c.files.WriteFile(*out, []byte(template), 0644); err != nil {
+			return err
+		}
+	}
+	switch {
+	case c.json && *out != "":
+		return c.writeJSON(struct{ Path string }{*out})
+	case c.json:
+		return c.writeJSON(json.RawMessage(template))
+	case *out == "":
+		fmt.Fprintln(c.stdout, strings.TrimRight(template, "\n"))
+	}
+	return nil
+}
+
+func runVerify(c *cli, args []string) error {
+	flags := c.newFlags("verify", "<template>")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	template, _, err := c.service.GetTemplate(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	verification, err := flixkit.Verify(template)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		type pin struct {
+			flixkit.NetworkPinVerification
+			Verified bool
+		}
+		pins := make([]pin, 0, len(verification.NetworkPins))
+		for _, p := range verification.NetworkPins {
+			pins = append(pins, pin{p, p.Verified()})
+		}
+		err = c.writeJSON(struct {
+			ID          string
+			ComputedID  string
+			NetworkPins []pin
+			Verified    bool
+		}{verification.ID, verification.ComputedID, pins, verification.Verified()})
+	} else {
+		fmt.Fprintf(c.stdout, "id %s: %s\n", verification.ID, verdict(verification.ID == verification.ComputedID, verification.ComputedID))
+		for _, p := range verification.NetworkPins {
+			fmt.Fprintf(c.stdout, "network pin %s %s: %s\n", p.Network, p.Pin, verdict(p.Verified(), p.ComputedPin))
+		}
+	}
+	if err == nil && !verification.Verified() {
+		return errFailed
+	}
+	return err
+}
+
+func verdict(ok bool, computed string) string {
+	switch {
+	case ok:
+		return "ok"
+	case computed == "":
+		return "cannot be computed"
+	}
+	return "mismatch, computed " + computed
+}
+
+func runLint(c *cli, args []string) error {
+	flags := c.newFlags("lint", "<template>")
+	args, err := parse(flags, args, 1, 1)
+	if err != nil {
+		return err
+	}
+	template, _, err := c.service.GetTemplate(context.Background(), args[0])
+	if err != nil {
+		return err
+	}
+	issues, err := flixkit.Lint(template)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		err = c.writeJSON(struct{ Issues []flixkit.LintIssue }{append([]flixkit.LintIssue{}, issues...)})
+	} else {
+		for _, issue := range issues {
+			fmt.Fprintf(c.stdout, "%s: %s\n", issue.Rule, issue.Message)
+		}
+	}
+	if err == nil && len(issues) > 0 {
+		return errFailed
+	}
+	return err
+}
+
+func runDiff(c *cli, args []string) error {
+	flags := c.newFlags("diff", "<old template> <new template>")
+	args, err := parse(flags, args, 2, 2)
+	if err != nil {
+		return err
+	}
+	ctx := context.Background()
+	oldTemplate, _, err := c.service.GetTemplate(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	newTemplate, _, err := c.service.GetTemplate(ctx, args[1])
+	if err != nil {
+		return err
+	}
+	diff, err := flixkit.Diff(oldTemplate, newTemplate)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		err = c.writeJSON(struct {
+			*flixkit.TemplateDiff
+			HasChanges bool
+		}{diff, diff.HasChanges()})
+	} else {
+		fmt.Fprint(c.stdout, diff.String())
+	}
+	if err == nil && diff.HasChanges() {
+		return errFailed
+	}
+	return err
+}
+
+func runCheck(c *cli, args []string) error {
+	flags := c.newFlags("check", "<binding file> <template>...")
+	args, err := parse(flags, args, 2, -1)
+	if err != nil {
+		return err
+	}
+	binding, err := c.files.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var templates []string
+	for _, name := range args[1:] {
+		template, _, err := c.service.GetTemplate(context.Background(), name)
+		if err != nil {
+			return err
+		}
+		templates = append(templates, template)
+	}
+	check, err := flixkit.CheckBinding(string(binding), templates...)
+	if err != nil {
+		return err
+	}
+	if c.json {
+		err = c.writeJSON(check)
+	} else if check.Stale {
+		fmt.Fprintf(c.stdout, "%s is stale, it was generated from %s\n", args[0], strings.Join(check.BindingIDs, ", "))
+		for i, t := range check.Templates {
+			switch {
+			case t.ID != t.ComputedID:
+				fmt.Fprintf(c.stdout, "%s: id %s does not match its content, computed %s\n", args[i+1], t.ID, t.ComputedID)
+			case !t.Recorded:
+				fmt.Fprintf(c.stdout, "%s: template id is %s\n", args[i+1], t.ComputedID)
+			}
+		}
+	} else {
+		fmt.Fprintf(c.stdout, "%s is up to date\n", args[0])
+	}
+	if err == nil && check.Stale {
+		return errFailed
+	}
+	return err
+}
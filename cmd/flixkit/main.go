@@ -0,0 +1,279 @@
+// Command flixkit fetches, resolves, generates and checks Flow Interaction Templates.
+//
+// Usage:
+//
+//	flixkit [global flags] <command> [flags] <arguments>
+//
+// Exit codes are stable for scripting: 0 on success, 1 on errors, 2 on invalid usage
+// and 3 when verify, lint, diff or check found a problem.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/onflow/flixkit-go/v2/flixkit"
+)
+
+const (
+	exitOK     = 0
+	exitError  = 1
+	exitUsage  = 2
+	exitFailed = 3
+)
+
+// errUsage marks errors caused by invalid arguments, they exit with exitUsage
+var errUsage = errors.New("usage")
+
+// errFailed is returned by commands whose check did not pass, the result is already written
+var errFailed = errors.New("check failed")
+
+func usageError(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errUsage, fmt.Sprintf(format, args...))
+}
+
+type command struct {
+	name    string
+	args    string
+	summary string
+	run     func(cli *cli, args []string) error
+}
+
+var commands = []command{
+	{"get", "<template>", "print a template by name, id, url or file", runGet},
+	{"resolve", "<template>", "print the cadence of a template with imports replaced for a network", runResolve},
+	{"bindings", "<template>...", "create bindings of one template or a bundle of many", runBindings},
+	{"generate", "<cadence file>", "create a template from cadence", runGenerate},
+	{"verify", "<template>", "recompute the id and network pins of a 1.1.0 template", runVerify},
+	{"lint", "<template>", "check a template for missing messages, mismatched parameters and unresolvable networks", runLint},
+	{"diff", "<old template> <new template>", "compare two templates", runDiff},
+	{"check", "<binding file> <template>...", "check whether a binding was generated from the current templates", runCheck},
+}
+
+/*
+State shared by the commands, the global flags mirror FlixServiceConfig
+*/
+type cli struct {
+	stdout  io.Writer
+	stderr  io.Writer
+	json    bool
+	files   osFiles
+	service flixkit.FlixService
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run executes the command line and returns the exit code
+func run(args []string, stdout io.Writer, stderr io.Writer) int {
+	c := &cli{stdout: stdout, stderr: stderr}
+	config := &flixkit.FlixServiceConfig{FileReader: c.files, FileWriter: c.files}
+	customBindings := keyValues{}
+	bindingBases := keyValues{}
+	var coreContracts string
+	var verbose bool
+
+	flags := flag.NewFlagSet("flixkit", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	flags.StringVar(&config.FlixServerURL, "server", "", "flix server url, defaults to https://flix.flow.com/v1/templates")
+	flags.StringVar(&coreContracts, "core-contracts", "", "json file of core contract addresses by contract and network")
+	flags.Var(customBindings, "binding", "`lang=dir` custom binding of the *.tmpl files in dir, can be repeated")
+	flags.Var(bindingBases, "binding-base", "`lang=base` built-in language the custom binding of lang extends")
+	flags.BoolVar(&c.json, "json", false, "write results as json")
+	flags.BoolVar(&verbose, "verbose", false, "log debug messages to stderr")
+	flags.Usage = func() { printUsage(flags) }
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return exitOK
+		}
+		return exitUsage
+	}
+	if flags.NArg() == 0 {
+		printUsage(flags)
+		return exitUsage
+	}
+
+	var cmd *command
+	for i := range commands {
+		if commands[i].name == flags.Arg(0) {
+			cmd = &commands[i]
+		}
+	}
+	if cmd == nil {
+		fmt.Fprintf(stderr, "unknown command %s\n", flags.Arg(0))
+		printUsage(flags)
+		return exitUsage
+	}
+
+	config.Logger = logger{out: stderr, verbose: verbose}
+	if coreContracts != "" {
+		if err := c.readJSON(coreContracts, &config.CoreContracts); err != nil {
+			return c.fail(err)
+		}
+	}
+	for lang, dir := range customBindings {
+		config.CustomBindings = mapSet(config.CustomBindings, lang, flixkit.CustomBinding{FS: os.DirFS(dir), Base: bindingBases[lang]})
+	}
+	c.service = flixkit.NewFlixService(config)
+
+	return c.fail(cmd.run(c, flags.Args()[1:]))
+}
+
+func printUsage(flags *flag.FlagSet) {
+	out := flags.Output()
+	fmt.Fprintf(out, "Usage: flixkit [global flags] <command> [flags] <arguments>\n\nCommands:\n")
+	for _, cmd := range commands {
+		fmt.Fprintf(out, "  %-9s %s\n", cmd.name, cmd.summary)
+	}
+	fmt.Fprintf(out, "\nGlobal flags:\n")
+	flags.PrintDefaults()
+}
+
+// fail reports the error of a command and returns its exit code
+func (c *cli) fail(err error) int {
+	switch {
+	case err == nil:
+		return exitOK
+	case errors.Is(err, flag.ErrHelp):
+		return exitOK
+	case errors.Is(err, errFailed):
+		return exitFailed
+	case errors.Is(err, errUsage):
+		// bare errUsage is returned after the flag set printed the problem
+		if err != errUsage {
+			fmt.Fprintln(c.stderr, strings.TrimPrefix(err.Error(), errUsage.Error()+": "))
+		}
+		return exitUsage
+	}
+	if c.json {
+		_ = c.writeJSON(map[string]string{"error": err.Error()})
+	} else {
+		fmt.Fprintf(c.stderr, "error: %s\n", err)
+	}
+	return exitError
+}
+
+// newFlags returns the flag set of a command, parse errors are reported by the flag set
+func (c *cli) newFlags(name string, args string) *flag.FlagSet {
+	flags := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags.SetOutput(c.stderr)
+	flags.Usage = func() {
+		fmt.Fprintf(c.stderr, "Usage: flixkit %s [flags] %s\n", name, args)
+		flags.PrintDefaults()
+	}
+	return flags
+}
+
+// parse parses the flags of a command and checks the number of arguments, max -1 allows any number
+func parse(flags *flag.FlagSet, args []string, min int, max int) ([]string, error) {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return nil, err
+		}
+		return nil, errUsage
+	}
+	if flags.NArg() < min || (max >= 0 && flags.NArg() > max) {
+		flags.Usage()
+		return nil, errUsage
+	}
+	return flags.Args(), nil
+}
+
+func (c *cli) writeJSON(v any) error {
+	encoder := json.NewEncoder(c.stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(v)
+}
+
+func (c *cli) readJSON(path string, v any) error {
+	file, err := c.files.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(file, v); err != nil {
+		return fmt.Errorf("could not parse %s: %w", path, err)
+	}
+	return nil
+}
+
+/*
+FileReader, DirReader and FileWriter of the local file system, MkdirAll creates the directories of written bindings
+*/
+type osFiles struct{}
+
+func (osFiles) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func (osFiles) ReadDir(path string) ([]fs.DirEntry, error) {
+	return os.ReadDir(path)
+}
+
+func (osFiles) WriteFile(path string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(path, data, perm)
+}
+
+func (osFiles) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+/*
+Logger writing info and error messages to stderr, debug messages are only written when verbose
+*/
+type logger struct {
+	out     io.Writer
+	verbose bool
+}
+
+func (l logger) Debug(msg string) {
+	if l.verbose {
+		fmt.Fprintln(l.out, msg)
+	}
+}
+
+func (l logger) Info(msg string) {
+	fmt.Fprintln(l.out, msg)
+}
+
+func (l logger) Error(msg string) {
+	fmt.Fprintln(l.out, "error: "+msg)
+}
+
+/*
+Repeatable key=value flag
+*/
+type keyValues map[string]string
+
+func (kv keyValues) String() string {
+	pairs := make([]string, 0, len(kv))
+	for k, v := range kv {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+func (kv keyValues) Set(value string) error {
+	k, v, ok := strings.Cut(value, "=")
+	if !ok || k == "" {
+		return fmt.Errorf("expected key=value, got %s", value)
+	}
+	kv[k] = v
+	return nil
+}
+
+func mapSet[V any](m map[string]V, key string, value V) map[string]V {
+	if m == nil {
+		m = make(map[string]V)
+	}
+	m[key] = value
+	return m
+}
@@ -0,0 +1,192 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const greetingCadence = `#interaction(
+    version: "1.1.0",
+    title: "Update Greeting",
+    description: "Update the greeting on the HelloWorld contract",
+    language: "en-US",
+    parameters: [
+        Parameter(
+            name: "greeting",
+            title: "Greeting",
+            description: "The greeting to set on the HelloWorld contract"
+        )
+    ],
+)
+
+transaction(greeting: String) {
+    prepare(acct: &Account) {
+        log(acct.address)
+    }
+    execute {
+        log(greeting)
+    }
+}
+`
+
+func runCLI(args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+	code := run(args, &stdout, &stderr)
+	return code, stdout.String(), stderr.String()
+}
+
+// generateTemplate writes the greeting cadence to dir and generates its template with the generate command
+func generateTemplate(t *testing.T, dir string) string {
+	cadence := filepath.Join(dir, "greeting.cdc")
+	template := filepath.Join(dir, "greeting.json")
+	assert.NoError(t, os.WriteFile(cadence, []byte(greetingCadence), 0644))
+	code, stdout, stderr := runCLI("generate", "-out", template, cadence)
+	assert.Equal(t, exitOK, code, stderr)
+	assert.Empty(t, stdout)
+	return template
+}
+
+func TestUsage(t *testing.T) {
+	assert := assert.New(t)
+	code, _, stderr := runCLI()
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "Commands:")
+
+	code, _, stderr = runCLI("publish")
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "unknown command publish")
+
+	code, _, stderr = runCLI("diff", "a.json")
+	assert.Equal(exitUsage, code)
+	assert.Contains(stderr, "Usage: flixkit diff [flags] <old template> <new template>")
+
+	code, _, _ = runCLI("lint", "-unknown", "a.json")
+	assert.Equal(exitUsage, code)
+
+	code, _, stderr = runCLI("bindings", "a.json", "b.json")
+	assert.Equal(exitUsage, code)
+	assert.Equal("bindings of 2 templates need -bundle\n", stderr)
+
+	code, _, _ = runCLI("verify", "-h")
+	assert.Equal(exitOK, code)
+}
+
+func TestGenerateAndVerify(t *testing.T) {
+	assert := assert.New(t)
+	template := generateTemplate(t, t.TempDir())
+
+	code, stdout, _ := runCLI("verify", template)
+	assert.Equal(exitOK, code)
+	assert.Regexp(`^id [0-9a-f]{64}: ok\n$`, stdout)
+
+	code, stdout, _ = runCLI("lint", template)
+	assert.Equal(exitOK, code)
+	assert.Empty(stdout)
+
+	code, stdout, _ = runCLI("-json", "verify", template)
+	assert.Equal(exitOK, code)
+	var verification struct {
+		ID         string
+		ComputedID string
+		Verified   bool
+	}
+	assert.NoError(json.Unmarshal([]byte(stdout), &verification))
+	assert.True(verification.Verified)
+	assert.Equal(verification.ID, verification.ComputedID)
+
+	content, err := os.ReadFile(template)
+	assert.NoError(err)
+	tampered := filepath.Join(filepath.Dir(template), "tampered.json")
+	assert.NoError(os.WriteFile(tampered, bytes.Replace(content, []byte("log(greeting)"), []byte("log(greeting.length)"), 1), 0644))
+	code, stdout, _ = runCLI("verify", tampered)
+	assert.Equal(exitFailed, code)
+	assert.Contains(stdout, "mismatch, computed")
+
+	code, stdout, _ = runCLI("diff", template, tampered)
+	assert.Equal(exitFailed, code)
+	assert.Contains(stdout, "+        log(greeting.length)")
+
+	code, stdout, _ = runCLI("diff", template, template)
+	assert.Equal(exitOK, code)
+	assert.Equal("no changes\n", stdout)
+}
+
+func TestLint(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	template := generateTemplate(t, dir)
+	content, err := os.ReadFile(template)
+	assert.NoError(err)
+	renamed := filepath.Join(dir, "renamed.json")
+	assert.NoError(os.WriteFile(renamed, bytes.Replace(content, []byte(`"label": "greeting"`), []byte(`"label": "message"`), 1), 0644))
+
+	code, stdout, _ := runCLI("lint", renamed)
+	assert.Equal(exitFailed, code)
+	assert.Equal("parameter-mismatch: parameter greeting is not in the template\nparameter-mismatch: parameter message is not in the cadence\n", stdout)
+
+	code, stdout, _ = runCLI("-json", "lint", renamed)
+	assert.Equal(exitFailed, code)
+	assert.Contains(stdout, `"Rule": "parameter-mismatch"`)
+}
+
+func TestResolveAndBindings(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	template := generateTemplate(t, dir)
+
+	code, stdout, _ := runCLI("resolve", "-network", "local", "-alias", "local=emulator", template)
+	assert.Equal(exitOK, code)
+	assert.Contains(stdout, "transaction(greeting: String)")
+
+	code, stdout, _ = runCLI("bindings", "-lang", "ts", template)
+	assert.Equal(exitOK, code)
+	assert.Contains(stdout, "export async function updateGreeting")
+
+	binding := filepath.Join(dir, "greeting.ts")
+	code, stdout, _ = runCLI("bindings", "-lang", "ts", "-out", binding, template)
+	assert.Equal(exitOK, code)
+	assert.Equal(binding+"\n", stdout)
+
+	code, stdout, _ = runCLI("check", binding, template)
+	assert.Equal(exitOK, code)
+	assert.Contains(stdout, "is up to date")
+
+	content, err := os.ReadFile(template)
+	assert.NoError(err)
+	edited := filepath.Join(dir, "edited.json")
+	assert.NoError(os.WriteFile(edited, bytes.Replace(content, []byte("log(greeting)"), []byte("log(greeting.length)"), 1), 0644))
+	code, stdout, _ = runCLI("check", binding, edited)
+	assert.Equal(exitFailed, code)
+	assert.Contains(stdout, "does not match its content")
+
+	code, stdout, _ = runCLI("-json", "check", binding, edited)
+	assert.Equal(exitFailed, code)
+	assert.Contains(stdout, `"Stale": true`)
+
+	bundle := filepath.Join(dir, "bindings")
+	code, stdout, _ = runCLI("bindings", "-lang", "js", "-bundle", "modules", "-out", bundle, template)
+	assert.Equal(exitOK, code)
+	for _, path := range strings.Fields(stdout) {
+		assert.FileExists(path)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	assert := assert.New(t)
+	missing := filepath.Join(t.TempDir(), "missing.cdc")
+	code, _, stderr := runCLI("generate", missing)
+	assert.Equal(exitError, code)
+	assert.True(strings.HasPrefix(stderr, "error: "))
+
+	code, stdout, _ := runCLI("-json", "generate", missing)
+	assert.Equal(exitError, code)
+	var result struct{ Error string }
+	assert.NoError(json.Unmarshal([]byte(stdout), &result))
+	assert.Contains(result.Error, "missing.cdc")
+}
@@ -0,0 +1,16 @@
+package flixkit
+
+import (
+	"github.com/onflow/flixkit-go/v2/internal"
+)
+
+// BindingCheck reports whether a generated binding is stale, the binding records the template ids it was generated from.
+type BindingCheck = internal.BindingCheck
+type TemplateCheck = internal.TemplateCheck
+type BindingNetworkPin = internal.BindingNetworkPin
+
+// CheckBinding compares the contents of a generated binding with the raw v1.0 or v1.1 templates it was generated from,
+// a bundle is checked with all of its templates. Use FlixService.GetTemplate to fetch the current templates by name, id, url or file
+func CheckBinding(binding string, templates ...string) (*BindingCheck, error) {
+	return internal.CheckBinding(binding, templates...)
+}
@@ -0,0 +1,24 @@
+package flixkit
+
+import (
+	"github.com/onflow/flixkit-go/v2/internal"
+)
+
+// TemplateDiff is the semantic difference between two templates, String() renders it for humans.
+type TemplateDiff = internal.TemplateDiff
+type ChangeKind = internal.ChangeKind
+type ParameterChange = internal.ParameterChange
+type MessageChange = internal.MessageChange
+type DependencyChange = internal.DependencyChange
+type NetworkPinChange = internal.NetworkPinChange
+
+const (
+	ChangeAdded    = internal.ChangeAdded
+	ChangeRemoved  = internal.ChangeRemoved
+	ChangeModified = internal.ChangeModified
+)
+
+// Diff compares two raw v1.0 or v1.1 templates, use FlixService.GetTemplate to fetch them by name, id, url or file
+func Diff(oldTemplate string, newTemplate string) (*TemplateDiff, error) {
+	return internal.DiffTemplates(oldTemplate, newTemplate)
+}
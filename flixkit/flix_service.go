@@ -2,6 +2,7 @@ package flixkit
 
 import (
 	"context"
+	"text/template"
 
 	"github.com/onflow/flixkit-go/v2/internal"
 )
@@ -11,24 +12,106 @@ type FlixService interface {
 	GetTemplate(ctx context.Context, templateName string) (string, string, error)
 	// GetAndReplaceImports returns the raw flix template with cadence imports replaced
 	GetTemplateAndReplaceImports(ctx context.Context, templateName string, network string) (*FlowInteractionTemplateExecution, error)
+	// GetTemplateAndReplaceImportsWithOptions replaces cadence imports using network aliases and contract address overrides
+	GetTemplateAndReplaceImportsWithOptions(ctx context.Context, templateName string, network string, opts ResolveOptions) (*FlowInteractionTemplateExecution, error)
 	// GenerateBinding returns the generated binding given the language
 	GetTemplateAndCreateBinding(ctx context.Context, templateName string, lang string, destFile string) (string, error)
+	// GetTemplateAndCreateBindingWithOptions returns the generated binding, with Embed set the template is verified and inlined
+	GetTemplateAndCreateBindingWithOptions(ctx context.Context, templateName string, lang string, destFile string, opts BindingOptions) (string, error)
+	// GetTemplatesAndCreateBundle returns the bindings of many templates as one module or a module per template with an index module,
+	// directories are expanded to their json files when the FileReader is a DirReader
+	GetTemplatesAndCreateBundle(ctx context.Context, templateNames []string, lang string, destDir string, mode BundleMode) ([]BindingFile, error)
 	// GenerateTemplate returns the generated raw template
 	CreateTemplate(ctx context.Context, contractInfos ContractInfos, code string, preFill string, networks []NetworkConfig) (string, error)
+	// RefreshTemplate re-pins changed dependencies of an existing template and returns it with a summary of the changes
+	RefreshTemplate(ctx context.Context, templateName string, networks []NetworkConfig) (string, *RefreshSummary, error)
+	// CreateTemplates creates and writes templates for every Cadence file listed in a manifest
+	CreateTemplates(ctx context.Context, contractInfos ContractInfos, manifestPath string, networks []NetworkConfig) ([]BatchResult, error)
+	// GetTemplateAvailability reports for each network the template mentions whether it can be resolved there
+	GetTemplateAvailability(ctx context.Context, templateName string) ([]NetworkAvailability, error)
 }
 
 // FlowInteractionTemplateCadence is the interface returned from Replacing imports, it provides helper methods to assist in executing the resulting Cadence.
 type FlowInteractionTemplateExecution = internal.FlowInteractionTemplateExecution
+type ExecutionParameter = internal.ExecutionParameter
+
+// ResolveOptions maps network names to template networks and contracts to addresses used when replacing imports, the overrides used are reported as AppliedOverride.
+type ResolveOptions = internal.ResolveOptions
+type AppliedOverride = internal.AppliedOverride
+
+// NetworkAvailability lists the contracts and pins a template is missing on a network.
+type NetworkAvailability = internal.NetworkAvailability
+
+// BundleMode selects whether GetTemplatesAndCreateBundle creates a single module or a module per template, BindingFile is a file of the bundle.
+type BundleMode = internal.BundleMode
+type BindingFile = internal.BindingFile
+
+const (
+	BundleSingleModule = internal.BundleSingleModule
+	BundleModules      = internal.BundleModules
+)
+
+// BindingOptions configures GetTemplateAndCreateBindingWithOptions, Embed inlines the template after verifying its id
+// Numbers selects how JavaScript and TypeScript bindings return numbers and TxResults adds transaction functions returning decoded events.
+type BindingOptions = internal.BindingOptions
+type NumberType = internal.NumberType
+
+const (
+	NumberString  = internal.NumberString
+	NumberBigInt  = internal.NumberBigInt
+	NumberDecimal = internal.NumberDecimal
+)
+
+// CustomBinding is a set of text/template binding templates used for a language in FlixServiceConfig.CustomBindings,
+// the templates are executed with BindingData.
+type CustomBinding = internal.CustomBinding
+type BindingData = internal.BindingData
+type BindingParameter = internal.BindingParameter
+type BindingNetworkCadence = internal.BindingNetworkCadence
+type BindingInterface = internal.BindingInterface
+type BindingEvent = internal.BindingEvent
+
+// BindingFuncs returns the functions available to binding templates such as camelCase, jsType and fclType
+func BindingFuncs() template.FuncMap {
+	return internal.BindingFuncs()
+}
 
 // ContractInfos is an input into generating a template, it is a map of contract name to network information of deployed contracts of the source Cadence code.
 type ContractInfos = internal.ContractInfos
 type NetworkAddressMap = internal.NetworkAddressMap
 type NetworkConfig = internal.NetworkConfig
 
-// FlixServiceConfig is the configuration for the FlixService that provides a override for FlixServerURL and default values for FileReader and Logger.
+// RefreshSummary lists the dependency pins that moved while refreshing a template along with the old and new template id.
+type RefreshSummary = internal.RefreshSummary
+type DependencyPinChange = internal.DependencyPinChange
+
+// BatchManifest lists the Cadence files, optional pre-filled templates and output paths used by CreateTemplates.
+type BatchManifest = internal.BatchManifest
+type BatchEntry = internal.BatchEntry
+type BatchResult = internal.BatchResult
+
+// FlixServiceConfig is the configuration for the FlixService that provides a override for FlixServerURL and default values for FileReader, FileWriter and Logger.
 type FlixServiceConfig = internal.FlixServiceConfig
+type FileReader = internal.FileReader
+type FileWriter = internal.FileWriter
+type DirReader = internal.DirReader
+type DirMaker = internal.DirMaker
 
 // NewFlixService returns a new FlixService given a FlixServiceConfig
 func NewFlixService(config *FlixServiceConfig) FlixService {
 	return internal.NewFlixService(config)
 }
+
+// FlowProject holds the ContractInfos and NetworkConfigs of a Flow project, use it as input to CreateTemplate.
+type FlowProject = internal.FlowProject
+
+// LoadFlowProject reads contract aliases, dependencies and deployments from a flow.json using the FileReader
+func LoadFlowProject(reader FileReader, path string) (*FlowProject, error) {
+	return internal.LoadFlowProject(reader, path)
+}
+
+// CoreContracts returns a copy of the built-in registry of core contract addresses on mainnet, testnet and emulator,
+// these are used for imports missing from ContractInfos and can be overridden with FlixServiceConfig.CoreContracts
+func CoreContracts() ContractInfos {
+	return internal.CoreContracts()
+}
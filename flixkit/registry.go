@@ -0,0 +1,36 @@
+package flixkit
+
+import (
+	"net/http"
+
+	"github.com/onflow/flixkit-go/v2/internal"
+)
+
+// TemplateStore is the storage of a template registry, MemoryStore and DirStore are the built-in stores.
+type TemplateStore = internal.TemplateStore
+type MemoryStore = internal.MemoryStore
+type DirStore = internal.DirStore
+
+// ErrTemplateNotFound is returned by a TemplateStore when no template has the requested name or id
+var ErrTemplateNotFound = internal.ErrTemplateNotFound
+
+// NewRegistryHandler returns an http.Handler serving the templates of a store to FlixService,
+// set FlixServerURL to the address it is served at followed by /v1/templates
+func NewRegistryHandler(store TemplateStore) http.Handler {
+	return internal.NewRegistryHandler(store)
+}
+
+// NewMemoryStore returns an empty TemplateStore keeping templates in memory
+func NewMemoryStore() *MemoryStore {
+	return internal.NewMemoryStore()
+}
+
+// NewDirStore returns a TemplateStore of the json templates of a directory, uploads are written by id and indexed by name in names.json
+func NewDirStore(dir string) (*DirStore, error) {
+	return internal.NewDirStore(dir)
+}
+
+// ValidateRegistryTemplate returns the id of a 1.1.0 template after verifying its id and network pins
+func ValidateRegistryTemplate(template string) (string, error) {
+	return internal.ValidateRegistryTemplate(template)
+}
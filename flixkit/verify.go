@@ -0,0 +1,32 @@
+package flixkit
+
+import (
+	"github.com/onflow/flixkit-go/v2/internal"
+)
+
+// TemplateVerification compares the id and network pins of a template with the ones computed from its content.
+type TemplateVerification = internal.TemplateVerification
+type NetworkPinVerification = internal.NetworkPinVerification
+
+// LintIssue is a problem LintTemplate found in a template, Rule is one of the Lint constants.
+type LintIssue = internal.LintIssue
+
+const (
+	LintMissingMessage    = internal.LintMissingMessage
+	LintParameterMessage  = internal.LintParameterMessage
+	LintParameterMismatch = internal.LintParameterMismatch
+	LintInvalidCadence    = internal.LintInvalidCadence
+	LintUnresolvable      = internal.LintUnresolvable
+	LintMissingPin        = internal.LintMissingPin
+)
+
+// Verify computes the id and network pins of a raw 1.1.0 template, use FlixService.GetTemplate to fetch it by name, id, url or file
+func Verify(template string) (*TemplateVerification, error) {
+	return internal.VerifyTemplate(template)
+}
+
+// Lint checks a raw v1.0 or v1.1 template for missing messages, parameters that do not match its Cadence
+// and networks it cannot be resolved or verified on
+func Lint(template string) ([]LintIssue, error) {
+	return internal.LintTemplate(template)
+}
@@ -0,0 +1,168 @@
+package internal
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+type BundleMode string
+
+const (
+	// BundleSingleModule creates one index module with the functions of every template
+	BundleSingleModule BundleMode = "single"
+	// BundleModules creates a module per template, a types module and an index module exporting all of them
+	BundleModules BundleMode = "modules"
+)
+
+/*
+Template of a bundle, Location is the url or the path of the template relative to the bundle directory
+*/
+type BundleTemplate struct {
+	Template string
+	Location string
+}
+
+/*
+Generated file of a bundle, Path is relative to the bundle directory
+*/
+type BindingFile struct {
+	Path    string
+	Content string
+}
+
+type bundleData struct {
+	FclVersion string
+	Typed      bool
+	Templates  []BindingData
+	// Validators are the validation helpers of all templates
+	Validators map[string]bool
+}
+
+type bundleModuleData struct {
+	BindingData
+	Typed bool
+}
+
+// names of the modules every bundle creates, of the fcl import and of the validation helpers the templates share
+var bundleReservedNames = append([]string{"fcl", "index", "types"}, jsValidatorHelpers...)
+
+/*
+Names already used in a bundle, compared case insensitively since module names are file names
+*/
+type bundleNames map[string]bool
+
+func newBundleNames() bundleNames {
+	names := make(bundleNames)
+	for _, name := range bundleReservedNames {
+		names[strings.ToLower(name)] = true
+	}
+	return names
+}
+
+// assign gives the template a function, parameter type and template variable name no other template of the bundle uses,
+// clashing names are numbered in the order the templates are added
+func (n bundleNames) assign(data *BindingData) {
+	title, prefix := data.Title, data.ParametersPrefixName
+	for i := 2; n[strings.ToLower(data.Title)] || n[strings.ToLower(data.Title+"Template")]; i++ {
+		data.Title = fmt.Sprintf("%s%d", title, i)
+		data.ParametersPrefixName = fmt.Sprintf("%s%d", prefix, i)
+	}
+	data.TemplateVar = data.Title + "Template"
+	n[strings.ToLower(data.Title)] = true
+	n[strings.ToLower(data.TemplateVar)] = true
+}
+
+// compareVersions compares the numeric parts of two dotted versions such as 1.9.0, missing parts count as 0
+func compareVersions(a string, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < max(len(as), len(bs)); i++ {
+		if c := cmp.Compare(versionPart(as, i), versionPart(bs, i)); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+func versionPart(parts []string, i int) int {
+	if i >= len(parts) {
+		return 0
+	}
+	n, _ := strconv.Atoi(parts[i])
+	return n
+}
+
+// CreateBundle creates the bindings of many templates as one module or as a module per template with an index module
+func (g *FclCreator) CreateBundle(templates []BundleTemplate, mode BundleMode) ([]BindingFile, error) {
+	if g.fileExtension == "" {
+		return nil, fmt.Errorf("bundles are not supported for this language")
+	}
+	if len(templates) == 0 {
+		return nil, fmt.Errorf("no flix templates provided")
+	}
+	tmpl, err := parseTemplates(g.templates)
+	if err != nil {
+		return nil, err
+	}
+
+	names := newBundleNames()
+	bundle := bundleData{Typed: g.typed, Validators: make(map[string]bool)}
+	for _, t := range templates {
+		data, err := g.newBindingData(t.Template, t.Location, BindingOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("invalid template %s: %w", t.Location, err)
+		}
+		names.assign(&data)
+		data.decodeOutput(NumberString, data.ParametersPrefixName, g.typed)
+		data.ExportTypes = true
+		for name := range data.Validators {
+			bundle.Validators[name] = true
+		}
+		bundle.Templates = append(bundle.Templates, data)
+		if bundle.FclVersion == "" || compareVersions(data.FclVersion, bundle.FclVersion) > 0 {
+			bundle.FclVersion = data.FclVersion
+		}
+	}
+
+	index := "index." + g.fileExtension
+	switch mode {
+	case BundleSingleModule:
+		content, err := executeTemplate(tmpl, "bundle", bundle)
+		if err != nil {
+			return nil, err
+		}
+		return []BindingFile{{Path: index, Content: content}}, nil
+	case BundleModules:
+		var files []BindingFile
+		for _, data := range bundle.Templates {
+			content, err := executeTemplate(tmpl, "module", bundleModuleData{BindingData: data, Typed: g.typed})
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, BindingFile{Path: data.Title + "." + g.fileExtension, Content: content})
+		}
+		if g.typed {
+			content, err := executeTemplate(tmpl, "types", bundle)
+			if err != nil {
+				return nil, err
+			}
+			files = append(files, BindingFile{Path: "types." + g.fileExtension, Content: content})
+		}
+		content, err := executeTemplate(tmpl, "index", bundle)
+		if err != nil {
+			return nil, err
+		}
+		return append(files, BindingFile{Path: index, Content: content}), nil
+	default:
+		return nil, fmt.Errorf("bundle mode %s not supported", mode)
+	}
+}
+
+func executeTemplate(tmpl *template.Template, name string, data any) (string, error) {
+	var buf bytes.Buffer
+	err := tmpl.ExecuteTemplate(&buf, name, data)
+	return buf.String(), err
+}
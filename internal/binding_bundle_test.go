@@ -0,0 +1,117 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+// bundleTemplates has a template twice to make its names clash
+func bundleTemplates(t *testing.T) []BundleTemplate {
+	tx, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+	script, err := json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+	return []BundleTemplate{
+		{Template: string(tx), Location: "./update-greeting.template.json"},
+		{Template: string(script), Location: "https://flix.flow.com/v1/templates?name=get-greeting"},
+		{Template: string(tx), Location: "./update-greeting-copy.template.json"},
+	}
+}
+
+func joinBindingFiles(files []BindingFile) string {
+	var b strings.Builder
+	for _, file := range files {
+		b.WriteString("// " + file.Path + "\n" + file.Content + "\n")
+	}
+	return b.String()
+}
+
+func TestTSBundleSingleModule(t *testing.T) {
+	assert := assert.New(t)
+	files, err := NewFclTSCreator().CreateBundle(bundleTemplates(t), BundleSingleModule)
+	assert.NoError(err, "CreateBundle should not return an error")
+	assert.Len(files, 1)
+	assert.Equal("index.ts", files[0].Path)
+	autogold.ExpectFile(t, files[0].Content)
+}
+
+func TestTSBundleModules(t *testing.T) {
+	assert := assert.New(t)
+	files, err := NewFclTSCreator().CreateBundle(bundleTemplates(t), BundleModules)
+	assert.NoError(err, "CreateBundle should not return an error")
+	autogold.ExpectFile(t, joinBindingFiles(files))
+}
+
+func TestJSBundleModules(t *testing.T) {
+	assert := assert.New(t)
+	files, err := NewFclJSCreator().CreateBundle(bundleTemplates(t), BundleModules)
+	assert.NoError(err, "CreateBundle should not return an error")
+	autogold.ExpectFile(t, joinBindingFiles(files))
+}
+
+func TestBundleErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := NewFclTSCreator().CreateBundle(nil, BundleModules)
+	assert.Error(err, "empty bundle should return an error")
+	_, err = NewFclTSCreator().CreateBundle(bundleTemplates(t), BundleMode("tree"))
+	assert.Error(err, "unknown mode should return an error")
+	_, err = NewPythonCreator().CreateBundle(bundleTemplates(t), BundleModules)
+	assert.Error(err, "python bundles are not supported")
+}
+
+func TestBundleNames(t *testing.T) {
+	assert := assert.New(t)
+	names := newBundleNames()
+	first := BindingData{Title: "transfer", ParametersPrefixName: "Transfer"}
+	second := BindingData{Title: "transfer", ParametersPrefixName: "Transfer"}
+	reserved := BindingData{Title: "types", ParametersPrefixName: "Types"}
+	helper := BindingData{Title: "validateAddress", ParametersPrefixName: "ValidateAddress"}
+	names.assign(&first)
+	names.assign(&second)
+	names.assign(&reserved)
+	names.assign(&helper)
+	assert.Equal("transfer", first.Title)
+	assert.Equal("transferTemplate", first.TemplateVar)
+	assert.Equal("transfer2", second.Title)
+	assert.Equal("Transfer2", second.ParametersPrefixName)
+	assert.Equal("transfer2Template", second.TemplateVar)
+	assert.Equal("types2", reserved.Title)
+	assert.Equal("validateAddress2", helper.Title, "templates must not shadow the shared validation helpers")
+}
+
+func TestCompareVersions(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(1, compareVersions("1.10.0", "1.9.0"))
+	assert.Equal(-1, compareVersions("1.3.0", "1.9.0"))
+	assert.Equal(0, compareVersions("1.9", "1.9.0"))
+}
+
+func TestGetTemplatesAndCreateBundle(t *testing.T) {
+	assert := assert.New(t)
+	tx, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	script, err := json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(err, "marshal template to json should not return an error")
+	files := fstest.MapFS{
+		"templates/update.template.json": {Data: tx},
+		"templates/get.template.json":    {Data: script},
+		"templates/README.md":            {Data: []byte("not a template")},
+	}
+
+	flixService := NewFlixService(&FlixServiceConfig{FileReader: files})
+	bundle, err := flixService.GetTemplatesAndCreateBundle(context.Background(), []string{"templates"}, "ts", "bindings", BundleSingleModule)
+	assert.NoError(err, "GetTemplatesAndCreateBundle should not return an error")
+	assert.Len(bundle, 1)
+	assert.Contains(bundle[0].Content, `import requestTemplate from "../templates/get.template.json"`)
+	assert.Contains(bundle[0].Content, `import updateGreetingTemplate from "../templates/update.template.json"`)
+	assert.NotContains(bundle[0].Content, "README")
+
+	_, err = flixService.GetTemplatesAndCreateBundle(context.Background(), []string{"templates"}, "go", "bindings", BundleModules)
+	assert.Error(err, "go bundles are not supported")
+}
@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"fmt"
+	"io/fs"
+	"maps"
+	"slices"
+	"text/template"
+)
+
+/*
+Binding templates supplied by the user, text/template sources executed with BindingData and the functions of BindingFuncs.
+Files of FS matching Patterns, *.tmpl by default, are parsed first and Templates after them.
+Base is a built-in language whose templates are parsed before the custom ones, so only the defined templates that change
+have to be supplied. Without Base the custom templates need a main template outside of any define
+*/
+type CustomBinding struct {
+	Base      string
+	FS        fs.FS
+	Patterns  []string
+	Templates []string
+}
+
+// BindingFuncs returns the functions available to binding templates, use it to parse custom templates on their own
+func BindingFuncs() template.FuncMap {
+	return template.FuncMap(maps.Clone(bindingFuncs))
+}
+
+func (b CustomBinding) creator(destFile string) (*FclCreator, error) {
+	creator := &FclCreator{}
+	if b.Base != "" {
+		base, err := newBindingCreator(b.Base, destFile)
+		if err != nil {
+			return nil, fmt.Errorf("invalid custom binding base: %w", err)
+		}
+		creator = base
+	}
+	sources, err := b.sources()
+	if err != nil {
+		return nil, err
+	}
+	creator.templates = append(slices.Clone(creator.templates), sources...)
+	return creator, nil
+}
+
+func (b CustomBinding) sources() ([]string, error) {
+	var sources []string
+	if b.FS != nil {
+		patterns := b.Patterns
+		if len(patterns) == 0 {
+			patterns = []string{"*.tmpl"}
+		}
+		for _, pattern := range patterns {
+			matches, err := fs.Glob(b.FS, pattern)
+			if err != nil {
+				return nil, fmt.Errorf("invalid binding template pattern %s: %w", pattern, err)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("no binding templates match %s", pattern)
+			}
+			for _, match := range matches {
+				source, err := fs.ReadFile(b.FS, match)
+				if err != nil {
+					return nil, fmt.Errorf("could not read binding template %s: %w", match, err)
+				}
+				sources = append(sources, string(source))
+			}
+		}
+	}
+	sources = append(sources, b.Templates...)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("custom binding has no templates")
+	}
+	return sources, nil
+}
@@ -0,0 +1,81 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomBindingFS(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(complexParamTemplate)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	files := fstest.MapFS{
+		"main.tmpl":   {Data: []byte(`import { query } from "./wrapper"{{"\n"}}{{template "fn" .}}`)},
+		"fn.tmpl":     {Data: []byte(`{{define "fn"}}export const {{camelCase .Title}} = ({{range $i, $p := .Parameters}}{{if $i}}, {{end}}{{$p.Name}}: {{jsType $p.Type}}{{end}}) => query({{.Location | printf "%q"}}){{end}}`)},
+		"ignored.txt": {Data: []byte("not a template")},
+	}
+	gen, err := CustomBinding{FS: files}.creator("./bindings/complex.ts")
+	assert.NoError(err, "creator should not return an error")
+	out, err := gen.Create(string(ttemp), "./complex.template.json")
+	assert.NoError(err, "Create should not return an error")
+	assert.Equal(`import { query } from "./wrapper"
+export const request = (matrix: Array<Array<string>>, balances: Record<string, string>, owner: string | null, path: { domain: string; identifier: string }) => query("./complex.template.json")`, out)
+}
+
+func TestCustomBindingBase(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	// replace only the transaction function of the built-in TypeScript templates
+	gen, err := CustomBinding{
+		Base:      "ts",
+		Templates: []string{`{{define "tx"}}export const {{.Title}} = wrapper.mutate({{.TemplateVar}}){{end}}`},
+	}.creator("./bindings/update.ts")
+	assert.NoError(err, "creator should not return an error")
+	out, err := gen.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "Create should not return an error")
+	assert.Contains(out, "interface UpdateGreetingParams {")
+	assert.Contains(out, "export const updateGreeting = wrapper.mutate(flixTemplate)")
+	assert.NotContains(out, "fcl.mutate")
+}
+
+func TestCustomBindingErrors(t *testing.T) {
+	assert := assert.New(t)
+	_, err := CustomBinding{}.creator("")
+	assert.Error(err, "custom binding without templates should return an error")
+	_, err = CustomBinding{FS: fstest.MapFS{}, Patterns: []string{"*.tmpl"}}.creator("")
+	assert.Error(err, "pattern without matches should return an error")
+	_, err = CustomBinding{Base: "cobol", Templates: []string{"x"}}.creator("")
+	assert.Error(err, "unknown base should return an error")
+
+	gen, err := CustomBinding{Templates: []string{"{{.Missing}}"}}.creator("")
+	assert.NoError(err, "creator should not return an error")
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	_, err = gen.Create(string(ttemp), "./min.template.json")
+	assert.Error(err, "unknown field should return an error")
+}
+
+func TestGetTemplateAndCreateCustomBinding(t *testing.T) {
+	assert := assert.New(t)
+	flixService := NewFlixService(&FlixServiceConfig{
+		FileReader: DefaultReader{},
+		CustomBindings: map[string]CustomBinding{
+			"ts":      {Templates: []string{"// {{.Title}} {{len .Parameters}}"}},
+			"summary": {Templates: []string{"{{.Title}}: {{.Description}}"}},
+		},
+	})
+	out, err := flixService.GetTemplateAndCreateBinding(context.Background(), "./transfer.json", "summary", "./bindings/transfer.txt")
+	assert.NoError(err, "GetTemplateAndCreateBinding should not return an error")
+	assert.Equal("transferTokens: Transfer tokens from one account to another", out)
+
+	out, err = flixService.GetTemplateAndCreateBinding(context.Background(), "./transfer.json", "ts", "./bindings/transfer.ts")
+	assert.NoError(err, "GetTemplateAndCreateBinding should not return an error")
+	assert.Equal("// transferTokens 2", out)
+}
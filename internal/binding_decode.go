@@ -0,0 +1,228 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	cadenceCommon "github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+)
+
+type NumberType string
+
+const (
+	// NumberString decodes all numbers of script results to strings, this is the default
+	NumberString NumberType = "string"
+	// NumberBigInt decodes integers to bigint and fixed point numbers to strings
+	NumberBigInt NumberType = "bigint"
+	// NumberDecimal decodes integers to bigint and fixed point numbers to Decimal of decimal.js
+	NumberDecimal NumberType = "decimal"
+)
+
+/*
+Interface of a struct the script declares, script results of the struct are decoded to it
+*/
+type BindingInterface struct {
+	Name   string
+	Fields []BindingParameter
+}
+
+/*
+Structs and the main return type of a script, Output is empty when the script cannot be parsed
+*/
+type scriptDeclarations struct {
+	Output  string
+	Structs map[string]*ast.CompositeDeclaration
+}
+
+var mainReturnPattern = regexp.MustCompile(`\bfun\s+main\s*\([^)]*\)\s*:\s*([^{]+?)\s*\{`)
+
+// parseScriptDeclarations finds the return type of main and the structs of a script,
+// imports are removed first since v1.0 templates import from placeholder addresses.
+// Only the return type is found for code the current Cadence parser no longer accepts
+func parseScriptDeclarations(code string) scriptDeclarations {
+	declarations := scriptDeclarations{Structs: make(map[string]*ast.CompositeDeclaration)}
+	program, err := parser.ParseProgram(nil, []byte(importLinePattern.ReplaceAllString(code, "")), parser.Config{})
+	if err != nil {
+		if match := mainReturnPattern.FindStringSubmatch(code); match != nil {
+			declarations.Output = match[1]
+		}
+		return declarations
+	}
+	for _, composite := range program.CompositeDeclarations() {
+		if composite.CompositeKind == cadenceCommon.CompositeKindStructure {
+			declarations.Structs[composite.Identifier.Identifier] = composite
+		}
+	}
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier != "main" {
+			continue
+		}
+		declarations.Output = "Void"
+		if function.ReturnTypeAnnotation != nil && function.ReturnTypeAnnotation.Type.String() != "" {
+			declarations.Output = function.ReturnTypeAnnotation.Type.String()
+		}
+	}
+	return declarations
+}
+
+/*
+Converts the values fcl decodes script results to into the declared TypeScript types,
+fcl returns numbers as JavaScript numbers or strings depending on its version and decoders
+*/
+type jsDecoder struct {
+	numbers NumberType
+	// prefix of the interface names, bundles prefix them to keep the names of all scripts unique
+	prefix     string
+	structs    map[string]*ast.CompositeDeclaration
+	interfaces []BindingInterface
+	// decoding holds the structs being decoded, recursive structs are not decoded further
+	decoding []string
+	decimal  bool
+	// typed decoders annotate the parameters of callbacks since fcl results are untyped
+	typed bool
+}
+
+func newJsDecoder(numbers NumberType, prefix string, structs map[string]*ast.CompositeDeclaration, typed bool) *jsDecoder {
+	if numbers == "" {
+		numbers = NumberString
+	}
+	return &jsDecoder{numbers: numbers, prefix: prefix, structs: structs, typed: typed}
+}
+
+// numberType returns the decoded type and conversion of a number type
+func (d *jsDecoder) numberType(name string) (string, string) {
+	fixedPoint := name == "Fix64" || name == "UFix64"
+	switch {
+	case d.numbers == NumberBigInt && !fixedPoint, d.numbers == NumberDecimal && !fixedPoint:
+		return "bigint", "BigInt(%s)"
+	case d.numbers == NumberDecimal:
+		d.decimal = true
+		return "Decimal", "new Decimal(String(%s))"
+	}
+	return "string", "String(%s)"
+}
+
+// decode returns the decoded TypeScript type and the expression that decodes the expression
+func (d *jsDecoder) decode(t ast.Type, expr string) (string, string) {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := t.String()
+		if slices.Contains(cadenceNumberTypes, name) {
+			jsType, conversion := d.numberType(name)
+			return jsType, fmt.Sprintf(conversion, expr)
+		}
+		if composite, ok := d.structs[name]; ok && !slices.Contains(d.decoding, name) {
+			return d.prefix + name, d.decodeStruct(composite, expr)
+		}
+		if slices.Contains(cadenceAbstractTypes, name) || d.structs[name] != nil {
+			return "any", expr
+		}
+		return jsTypeOf(t), expr
+	case *ast.OptionalType:
+		jsType, inner := d.decode(t.Type, expr)
+		if inner == expr {
+			return jsType + " | null", expr
+		}
+		return jsType + " | null", fmt.Sprintf("%s == null ? null : %s", expr, inner)
+	case *ast.VariableSizedType:
+		return d.decodeArray(t.Type, expr)
+	case *ast.ConstantSizedType:
+		return d.decodeArray(t.Type, expr)
+	case *ast.DictionaryType:
+		key := jsTypeOf(t.KeyType)
+		if key != "string" {
+			key = "string"
+		}
+		jsType, inner := d.decode(t.ValueType, "v")
+		if inner == "v" {
+			return fmt.Sprintf("Record<%s, %s>", key, jsType), expr
+		}
+		return fmt.Sprintf("Record<%s, %s>", key, jsType), fmt.Sprintf("Object.fromEntries(Object.entries(%s).map(([k, v]) => [k, %s]))", expr, inner)
+	case nil:
+		return "any", expr
+	}
+	return jsTypeOf(t), expr
+}
+
+func (d *jsDecoder) decodeArray(elem ast.Type, expr string) (string, string) {
+	jsType, inner := d.decode(elem, "v")
+	if inner == "v" {
+		return "Array<" + jsType + ">", expr
+	}
+	param := "v"
+	if d.typed {
+		param = "v: any"
+	}
+	return "Array<" + jsType + ">", fmt.Sprintf("%s.map((%s) => %s)", expr, param, arrowBody(inner))
+}
+
+// arrowBody wraps object literals so they are not parsed as the block of an arrow function
+func arrowBody(expr string) string {
+	if strings.HasPrefix(expr, "{") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
+
+// decodeStruct adds the interface of the struct and returns the expression that decodes its fields
+func (d *jsDecoder) decodeStruct(composite *ast.CompositeDeclaration, expr string) string {
+	name := composite.Identifier.Identifier
+	d.decoding = append(d.decoding, name)
+	defer func() { d.decoding = d.decoding[:len(d.decoding)-1] }()
+
+	var fields []decodeField
+	for _, field := range composite.Members.Fields() {
+		fields = append(fields, decodeField{name: field.Identifier.Identifier, t: field.TypeAnnotation.Type})
+	}
+	return d.decodeFields(d.prefix+name, fields, expr)
+}
+
+type decodeField struct {
+	name string
+	t    ast.Type
+}
+
+// decodeFields adds an interface with the fields and returns the expression that decodes them,
+// the expression is returned unchanged when no field needs to be decoded
+func (d *jsDecoder) decodeFields(interfaceName string, fields []decodeField, expr string) string {
+	var parameters []BindingParameter
+	var values []string
+	decoded := false
+	for _, field := range fields {
+		fieldExpr := expr + "." + field.name
+		jsType, value := d.decode(field.t, fieldExpr)
+		parameters = append(parameters, BindingParameter{Name: field.name, Type: field.t.String(), JsType: jsType})
+		if value != fieldExpr {
+			decoded = true
+		}
+		values = append(values, field.name+": "+value)
+	}
+	if !slices.ContainsFunc(d.interfaces, func(i BindingInterface) bool { return i.Name == interfaceName }) {
+		d.interfaces = append(d.interfaces, BindingInterface{Name: interfaceName, Fields: parameters})
+	}
+	if !decoded {
+		return expr
+	}
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+// decodeOutput sets the decoded type of the script output and the expression decoding the fcl result in info,
+// the output type is inferred from the return type of main when the template does not declare it
+func (data *BindingData) decodeOutput(numbers NumberType, interfacePrefix string, typed bool) {
+	if !data.IsScript {
+		return
+	}
+	declarations := parseScriptDeclarations(data.code)
+	if data.Output.Type == "" {
+		data.Output.Type = declarations.Output
+		data.Output.CadType = arrayElementType(declarations.Output)
+	}
+	decoder := newJsDecoder(numbers, interfacePrefix, declarations.Structs, typed)
+	data.Output.JsType, data.OutputDecode = decoder.decode(parseCadenceType(data.Output.Type), "info")
+	data.OutputInterfaces = decoder.interfaces
+	data.DecimalImport = decoder.decimal
+}
@@ -0,0 +1,134 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+var infoStruct = parseScriptDeclarations("access(all) struct Info { access(all) let balance: UFix64 }")
+
+func TestJsDecoder(t *testing.T) {
+	tests := []struct {
+		cadenceType string
+		numbers     NumberType
+		jsType      string
+		decode      string
+	}{
+		{"UFix64", "", "string", "String(x)"},
+		{"UInt64", NumberBigInt, "bigint", "BigInt(x)"},
+		{"UFix64", NumberBigInt, "string", "String(x)"},
+		{"Fix64", NumberDecimal, "Decimal", "new Decimal(String(x))"},
+		{"String?", "", "string | null", "x"},
+		{"Int?", "", "string | null", "x == null ? null : String(x)"},
+		{"[[UInt8]]", NumberBigInt, "Array<Array<bigint>>", "x.map((v) => v.map((v) => BigInt(v)))"},
+		{"{Address: UFix64}", "", "Record<string, string>", "Object.fromEntries(Object.entries(x).map(([k, v]) => [k, String(v)]))"},
+		{"{String: Bool}", "", "Record<string, boolean>", "x"},
+		{"[Info]", "", "Array<Info>", "x.map((v) => ({ balance: String(v.balance) }))"},
+		{"StoragePath", "", "{ domain: string; identifier: string }", "x"},
+		{"AnyStruct", "", "any", "x"},
+		{"Void", "", "void", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cadenceType+" "+string(tt.numbers), func(t *testing.T) {
+			jsType, decode := newJsDecoder(tt.numbers, "", infoStruct.Structs, false).decode(parseCadenceType(tt.cadenceType), "x")
+			assert.Equal(t, tt.jsType, jsType)
+			assert.Equal(t, tt.decode, decode)
+		})
+	}
+
+	// TypeScript strict mode rejects the implicitly any parameters of callbacks on untyped fcl results
+	typed := newJsDecoder(NumberBigInt, "", infoStruct.Structs, true)
+	_, decode := typed.decode(parseCadenceType("[[UInt8]]"), "x")
+	assert.Equal(t, "x.map((v: any) => v.map((v: any) => BigInt(v)))", decode)
+	_, decode = typed.decode(parseCadenceType("{String: [UInt64]}"), "x")
+	assert.Equal(t, "Object.fromEntries(Object.entries(x).map(([k, v]) => [k, v.map((v: any) => BigInt(v))]))", decode)
+}
+
+func TestParseScriptDeclarations(t *testing.T) {
+	assert := assert.New(t)
+	declarations := parseScriptDeclarations("import FungibleToken from 0xFUNGIBLETOKENADDRESS\naccess(all) struct Info {}\naccess(all) fun main(): [Info] { return [] }")
+	assert.Equal("[Info]", declarations.Output)
+	assert.Contains(declarations.Structs, "Info")
+	assert.Equal("Void", parseScriptDeclarations("access(all) fun main() {}").Output)
+	// restricted types are no longer parsed, the return type is still found
+	assert.Equal("UFix64", parseScriptDeclarations("access(all) fun main(a: Address): UFix64 { return getAccount(a).borrow<&Vault{Balance}>()!.balance }").Output)
+}
+
+var structOutputTemplate = &v1_1.InteractionTemplate{
+	FType:    "InteractionTemplate",
+	FVersion: "1.1.0",
+	ID:       "290b6b6222b2a77b16db896a80ddf29ebd1fa3038c9e6625a933fa213fce51fa",
+	Data: v1_1.Data{
+		Type: "script",
+		Messages: []v1_1.Message{
+			{Key: "title", I18n: []v1_1.I18n{{Tag: "en-US", Translation: "Get Accounts"}}},
+		},
+		Cadence: v1_1.Cadence{
+			Body: `access(all) struct Child {
+    access(all) let name: String
+    access(all) let parent: Child?
+}
+access(all) struct Info {
+    access(all) let balance: UFix64
+    access(all) let ids: [UInt64]
+    access(all) let child: Child?
+    access(all) let owner: Address
+}
+access(all) fun main(): {String: Info}? {
+    return nil
+}`,
+		},
+	},
+}
+
+func TestTSGenStructOutput(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(structOutputTemplate)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	out, err := NewFclTSCreator().CreateWithOptions(string(ttemp), "./accounts.template.json", BindingOptions{Numbers: NumberDecimal})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+var arrayOutputTemplate = &v1_1.InteractionTemplate{
+	FType:    "InteractionTemplate",
+	FVersion: "1.1.0",
+	ID:       "290b6b6222b2a77b16db896a80ddf29ebd1fa3038c9e6625a933fa213fce51fa",
+	Data: v1_1.Data{
+		Type: "script",
+		Messages: []v1_1.Message{
+			{Key: "title", I18n: []v1_1.I18n{{Tag: "en-US", Translation: "Get Holdings"}}},
+		},
+		Cadence: v1_1.Cadence{
+			Body: `access(all) struct Holding {
+    access(all) let ids: [UInt64]
+    access(all) let balances: {String: [UFix64]}
+}
+access(all) fun main(): [[Holding]] {
+    return []
+}`,
+		},
+	},
+}
+
+func TestTSGenStrictArrayOutput(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(arrayOutputTemplate)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	// every callback on the untyped fcl result declares its parameter so the binding compiles with tsc --strict
+	out, err := NewFclTSCreator().CreateWithOptions(string(ttemp), "./holdings.template.json", BindingOptions{Numbers: NumberBigInt})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	assert.NotContains(out, ".map((v) =>")
+	autogold.ExpectFile(t, out)
+
+	js, err := NewFclJSCreator().CreateWithOptions(string(ttemp), "./holdings.template.json", BindingOptions{Numbers: NumberBigInt})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	assert.NotContains(js, "v: any", "javascript bindings have no type annotations")
+}
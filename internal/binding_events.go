@@ -0,0 +1,121 @@
+package internal
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/onflow/cadence/ast"
+	cadenceCommon "github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+	"github.com/stoewer/go-strcase"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+/*
+Event a transaction binding decodes from the sealed transaction, Type is the event type without
+the contract address such as FungibleToken.Withdrawn and Decode is the arrow function body decoding the fcl event data in e.data
+*/
+type BindingEvent struct {
+	Type      string
+	Key       string
+	Interface string
+	Decode    string
+}
+
+/*
+Events and structs declared by a contract, nested composites are not included
+*/
+type contractDeclarations struct {
+	Events  []*ast.CompositeDeclaration
+	Structs map[string]*ast.CompositeDeclaration
+}
+
+// parseContractDeclarations finds the events and structs of the contracts and contract interfaces of the code
+func parseContractDeclarations(code string) (contractDeclarations, error) {
+	declarations := contractDeclarations{Structs: make(map[string]*ast.CompositeDeclaration)}
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return declarations, err
+	}
+	var members []*ast.Members
+	for _, composite := range program.CompositeDeclarations() {
+		members = append(members, composite.Members)
+	}
+	for _, contractInterface := range program.InterfaceDeclarations() {
+		members = append(members, contractInterface.Members)
+	}
+	for _, m := range members {
+		for _, composite := range m.Composites() {
+			switch composite.CompositeKind {
+			case cadenceCommon.CompositeKindEvent:
+				declarations.Events = append(declarations.Events, composite)
+			case cadenceCommon.CompositeKindStructure:
+				declarations.Structs[composite.Identifier.Identifier] = composite
+			}
+		}
+	}
+	return declarations, nil
+}
+
+// eventFields returns the parameters of an event declaration
+func eventFields(event *ast.CompositeDeclaration) []decodeField {
+	var fields []decodeField
+	for _, initializer := range event.Members.Initializers() {
+		if initializer.FunctionDeclaration.ParameterList == nil {
+			continue
+		}
+		for _, parameter := range initializer.FunctionDeclaration.ParameterList.Parameters {
+			fields = append(fields, decodeField{name: parameter.Identifier.Identifier, t: parameter.TypeAnnotation.Type})
+		}
+	}
+	return fields
+}
+
+// decodeEvents sets the events of the contracts the transaction imports, contracts holds the Cadence code
+// of the dependency contracts by name and the contracts without code are skipped
+func (data *BindingData) decodeEvents(numbers NumberType, contracts map[string]string, typed bool) error {
+	if data.IsScript {
+		return nil
+	}
+	data.TxResults = true
+	var imported []string
+	for _, imp := range v1_1.FindImports(data.code) {
+		for _, name := range imp.Contracts() {
+			if !slices.Contains(imported, name) {
+				imported = append(imported, name)
+			}
+		}
+	}
+	for _, name := range imported {
+		code, ok := contracts[name]
+		if !ok {
+			continue
+		}
+		declarations, err := parseContractDeclarations(code)
+		if err != nil {
+			return fmt.Errorf("could not parse contract %s: %w", name, err)
+		}
+		decoder := newJsDecoder(numbers, name, declarations.Structs, typed)
+		for _, event := range declarations.Events {
+			eventType := name + "." + event.Identifier.Identifier
+			if slices.ContainsFunc(data.Events, func(e BindingEvent) bool { return e.Type == eventType }) {
+				continue
+			}
+			interfaceName := name + event.Identifier.Identifier + "Event"
+			data.Events = append(data.Events, BindingEvent{
+				Type:      eventType,
+				Key:       strcase.LowerCamelCase(name + event.Identifier.Identifier),
+				Interface: interfaceName,
+				Decode:    arrowBody(decoder.decodeFields(interfaceName, eventFields(event), "e.data")),
+			})
+		}
+		for _, i := range decoder.interfaces {
+			if !slices.ContainsFunc(data.OutputInterfaces, func(o BindingInterface) bool { return o.Name == i.Name }) {
+				data.OutputInterfaces = append(data.OutputInterfaces, i)
+			}
+		}
+		data.DecimalImport = data.DecimalImport || decoder.decimal
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+var fungibleTokenContract = `access(all) contract interface FungibleToken {
+    access(all) struct VaultInfo {
+        access(all) let balance: UFix64
+        access(all) let uuid: UInt64
+    }
+    access(all) event Withdrawn(type: String, amount: UFix64, from: Address?, fromUUID: UInt64, withdrawnUUID: UInt64, balanceAfter: UFix64)
+    access(all) event Deposited(type: String, amount: UFix64, to: Address?, toUUID: UInt64, depositedUUID: UInt64, balanceAfter: UFix64)
+    access(all) event Burned(type: String, amount: UFix64, info: VaultInfo)
+    access(all) resource interface Vault {
+        access(all) event ResourceDestroyed(uuid: UInt64 = self.uuid)
+    }
+}`
+
+func TestParseContractDeclarations(t *testing.T) {
+	assert := assert.New(t)
+	declarations, err := parseContractDeclarations(fungibleTokenContract)
+	assert.NoError(err)
+	var events []string
+	for _, event := range declarations.Events {
+		events = append(events, event.Identifier.Identifier)
+	}
+	assert.Equal([]string{"Withdrawn", "Deposited", "Burned"}, events)
+	assert.Contains(declarations.Structs, "VaultInfo")
+	assert.Len(eventFields(declarations.Events[0]), 6)
+
+	_, err = parseContractDeclarations("access(all) contract {")
+	assert.Error(err)
+}
+
+func TestDecodeEvents(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	data, err := NewFclTSCreator().newBindingData(string(ttemp), "./transfer_token.json", BindingOptions{TxResults: true, Numbers: NumberBigInt, Contracts: map[string]string{
+		"FungibleToken": fungibleTokenContract,
+		"FlowToken":     "access(all) contract FlowToken { access(all) event TokensMinted(amount: UFix64) }",
+	}})
+	assert.NoError(err)
+	assert.True(data.TxResults)
+	assert.Len(data.Events, 3, "only events of imported contracts are decoded")
+	assert.Equal(BindingEvent{
+		Type:      "FungibleToken.Burned",
+		Key:       "fungibleTokenBurned",
+		Interface: "FungibleTokenBurnedEvent",
+		Decode:    "({ type: e.data.type, amount: String(e.data.amount), info: { balance: String(e.data.info.balance), uuid: BigInt(e.data.info.uuid) } })",
+	}, data.Events[2])
+
+	data, err = NewFclTSCreator().newBindingData(string(ttemp), "./transfer_token.json", BindingOptions{TxResults: true})
+	assert.NoError(err)
+	assert.True(data.TxResults)
+	assert.Empty(data.Events, "contracts without code are skipped")
+
+	_, err = NewFclTSCreator().newBindingData(string(ttemp), "./transfer_token.json", BindingOptions{TxResults: true, Contracts: map[string]string{"FungibleToken": "contract {"}})
+	assert.ErrorContains(err, "could not parse contract FungibleToken")
+}
+
+func TestTSGenTxResults(t *testing.T) {
+	ttemp, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewFclTSCreator().CreateWithOptions(string(ttemp), "./transfer_token.json", BindingOptions{TxResults: true, Contracts: map[string]string{"FungibleToken": fungibleTokenContract}})
+	assert.NoError(t, err, "CreateWithOptions should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestJSGenTxResults(t *testing.T) {
+	ttemp, err := json.Marshal(minimumNoParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewFclJSCreator().CreateWithOptions(string(ttemp), "./min.template.json", BindingOptions{TxResults: true})
+	assert.NoError(t, err, "CreateWithOptions should not return an error")
+	autogold.ExpectFile(t, out)
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"fmt"
+	"regexp"
+	"runtime/debug"
+	"slices"
+	"strings"
+	"time"
+)
+
+const flixkitModule = "github.com/onflow/flixkit-go/v2"
+
+/*
+Network pin of a template recorded in bindings, Pin is the pin_self of the network
+*/
+type BindingNetworkPin struct {
+	Network string
+	Pin     string
+}
+
+// flixkitVersion returns the version of the flixkit module the running program was built with,
+// devel is returned when flixkit is built from source
+func flixkitVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "devel"
+	}
+	version := info.Main.Version
+	if info.Main.Path != flixkitModule {
+		version = ""
+		for _, dep := range info.Deps {
+			if dep.Path == flixkitModule {
+				version = dep.Version
+			}
+		}
+	}
+	if version == "" || version == "(devel)" {
+		return "devel"
+	}
+	return version
+}
+
+// ProvenanceLines returns the lines binding headers record the template and generator with,
+// CheckBinding compares the flix-id line with the current template
+func (data BindingData) ProvenanceLines() []string {
+	lines := data.templateProvenanceLines()
+	if data.GeneratedAt != "" {
+		lines = append(lines, "flix-generated-at: "+data.GeneratedAt)
+	}
+	return append(lines, "flixkit-version: "+data.FlixkitVersion)
+}
+
+func (data BindingData) templateProvenanceLines() []string {
+	lines := []string{"flix-id: " + data.ID, "flix-source: " + data.Location}
+	for _, pin := range data.NetworkPins {
+		lines = append(lines, fmt.Sprintf("flix-pin-%s: %s", pin.Network, pin.Pin))
+	}
+	return lines
+}
+
+// ProvenanceLines returns the lines of every template of the bundle followed by the flixkit version
+func (bundle bundleData) ProvenanceLines() []string {
+	var lines []string
+	for _, data := range bundle.Templates {
+		lines = append(lines, data.templateProvenanceLines()...)
+	}
+	return append(lines, "flixkit-version: "+flixkitVersion())
+}
+
+// setProvenance sets the generator fields of the binding provenance, the timestamp is omitted
+// when generatedAt is zero so regenerating a binding only changes it when the template changed
+func (data *BindingData) setProvenance(generatedAt time.Time) {
+	data.FlixkitVersion = flixkitVersion()
+	if !generatedAt.IsZero() {
+		data.GeneratedAt = generatedAt.UTC().Format(time.RFC3339)
+	}
+}
+
+/*
+Template of a BindingCheck, ID is the id the template declares and ComputedID the id computed from its content.
+The id of v1.0 templates cannot be computed, their ComputedID is the declared id
+*/
+type TemplateCheck struct {
+	ID         string
+	ComputedID string
+	// Recorded reports whether the binding records the computed id
+	Recorded bool
+	Stale    bool
+}
+
+/*
+Result of CheckBinding, a binding is stale when one of its templates is stale: the binding does not record the id
+computed from the template content, or the id the template declares does not match its content
+*/
+type BindingCheck struct {
+	BindingIDs []string
+	Templates  []TemplateCheck
+	Stale      bool
+}
+
+var bindingIDPattern = regexp.MustCompile(`flix-id: ([0-9a-fA-F]+)`)
+
+// CheckBinding compares the template ids recorded in a generated binding with the ids computed from the current
+// v1.0 or v1.1 templates, bindings of a bundle are checked with every template of the bundle
+func CheckBinding(binding string, templates ...string) (*BindingCheck, error) {
+	check := &BindingCheck{}
+	for _, match := range bindingIDPattern.FindAllStringSubmatch(binding, -1) {
+		check.BindingIDs = append(check.BindingIDs, strings.ToLower(match[1]))
+	}
+	if len(check.BindingIDs) == 0 {
+		return nil, fmt.Errorf("binding does not record a template id, it was generated by an older flixkit version")
+	}
+	if len(templates) != len(check.BindingIDs) {
+		return nil, fmt.Errorf("binding records %d templates, %d were given", len(check.BindingIDs), len(templates))
+	}
+	for _, template := range templates {
+		t, err := checkTemplate(template)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse template: %w", err)
+		}
+		t.Recorded = slices.Contains(check.BindingIDs, t.ComputedID)
+		t.Stale = !t.Recorded || t.ID != t.ComputedID
+		check.Stale = check.Stale || t.Stale
+		check.Templates = append(check.Templates, t)
+	}
+	return check, nil
+}
+
+// checkTemplate returns the declared and computed id of a template
+func checkTemplate(template string) (TemplateCheck, error) {
+	t, err := toDiffTemplate(template)
+	if err != nil {
+		return TemplateCheck{}, err
+	}
+	check := TemplateCheck{ID: strings.ToLower(t.id), ComputedID: strings.ToLower(t.id)}
+	if t.version != "1.1.0" {
+		return check, nil
+	}
+	verification, err := VerifyTemplate(template)
+	if err != nil {
+		return TemplateCheck{}, err
+	}
+	check.ComputedID = verification.ComputedID
+	return check, nil
+}
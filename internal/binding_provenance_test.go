@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBindingProvenance(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	out, err := NewFclTSCreator().CreateWithOptions(string(ttemp), "./min.template.json", BindingOptions{GeneratedAt: time.Date(2024, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	assert.Contains(out, "    flix-id: "+minimumParamTemplateTS_TX.ID+"\n    flix-source: ./min.template.json\n    flix-generated-at: 2024-01-02T02:04:05Z\n    flixkit-version: devel\n")
+
+	out, err = NewFclTSCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "Create should not return an error")
+	assert.NotContains(out, "flix-generated-at", "bindings are deterministic without a generation time")
+
+	data := BindingData{ID: "abc", Location: "./a.json", FlixkitVersion: "v2.1.0", NetworkPins: []BindingNetworkPin{{Network: "mainnet", Pin: "123"}}}
+	assert.Equal([]string{"flix-id: abc", "flix-source: ./a.json", "flix-pin-mainnet: 123", "flixkit-version: v2.1.0"}, data.ProvenanceLines())
+}
+
+func TestCheckBinding(t *testing.T) {
+	assert := assert.New(t)
+	ttemp := verifiedTemplate(t, minimumParamTemplateTS_TX)
+	id, err := ValidateRegistryTemplate(ttemp)
+	assert.NoError(err)
+	binding, err := NewGoCreator("bindings").Create(ttemp, "./min.template.json")
+	assert.NoError(err, "Create should not return an error")
+
+	check, err := CheckBinding(binding, ttemp)
+	assert.NoError(err)
+	assert.Equal(&BindingCheck{
+		BindingIDs: []string{id},
+		Templates:  []TemplateCheck{{ID: id, ComputedID: id, Recorded: true}},
+	}, check)
+
+	// editing the cadence without updating the declared id still makes the binding stale
+	edited := strings.Replace(ttemp, "log(acct.address)", "log(acct)", 1)
+	check, err = CheckBinding(binding, edited)
+	assert.NoError(err)
+	assert.True(check.Stale)
+	assert.Equal(id, check.Templates[0].ID)
+	assert.NotEqual(id, check.Templates[0].ComputedID)
+	assert.False(check.Templates[0].Recorded)
+
+	updated := *minimumParamTemplateTS_TX
+	updated.Data.Cadence.Body = strings.Replace(updated.Data.Cadence.Body, "log(acct.address)", "log(acct)", 1)
+	utemp := verifiedTemplate(t, &updated)
+	check, err = CheckBinding(binding, utemp)
+	assert.NoError(err)
+	assert.True(check.Stale)
+	assert.Equal(check.Templates[0].ID, check.Templates[0].ComputedID)
+
+	bundle, err := NewFclTSCreator().CreateBundle([]BundleTemplate{
+		{Template: ttemp, Location: "./a.json"},
+		{Template: verifiedTemplate(t, minimumNoParamTemplateTS_SCRIPT), Location: "./b.json"},
+	}, BundleSingleModule)
+	assert.NoError(err)
+	check, err = CheckBinding(bundle[0].Content, ttemp, verifiedTemplate(t, minimumNoParamTemplateTS_SCRIPT))
+	assert.NoError(err)
+	assert.False(check.Stale)
+	assert.Len(check.BindingIDs, 2)
+
+	// one stale template makes the bundle stale
+	check, err = CheckBinding(bundle[0].Content, utemp, verifiedTemplate(t, minimumNoParamTemplateTS_SCRIPT))
+	assert.NoError(err)
+	assert.True(check.Stale)
+	assert.True(check.Templates[0].Stale)
+	assert.False(check.Templates[1].Stale)
+
+	_, err = CheckBinding(bundle[0].Content, ttemp)
+	assert.ErrorContains(err, "binding records 2 templates, 1 were given")
+	_, err = CheckBinding("// Code generated by flixkit", ttemp)
+	assert.ErrorContains(err, "does not record a template id")
+	_, err = CheckBinding(binding, "{}")
+	assert.ErrorContains(err, "could not parse template")
+}
+
+func TestFlixkitVersion(t *testing.T) {
+	assert.Equal(t, "devel", flixkitVersion())
+}
@@ -0,0 +1,662 @@
+package internal
+
+import (
+	"fmt"
+	"go/token"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser"
+	"github.com/stoewer/go-strcase"
+)
+
+/*
+Functions available to binding templates in addition to the text/template builtins
+*/
+var bindingFuncs = map[string]any{
+	"camelCase":     strcase.LowerCamelCase,
+	"pascalCase":    strcase.UpperCamelCase,
+	"snakeCase":     strcase.SnakeCase,
+	"jsType":        jsType,
+	"fclType":       fclType,
+	"jsArg":         jsArgValue,
+	"pythonType":    pythonType,
+	"pythonValue":   pythonValue,
+	"pythonString":  pythonString,
+	"pythonDecimal": pythonDecimal,
+	"goName":        goName,
+	"goType":        goType,
+	"goOutputType":  goOutputType,
+	"goString":      goString,
+	"goComment":     goComment,
+	"goEncode":      goEncodeArguments,
+	"goDecode":      goDecode,
+	"goImports":     goImports,
+	"swiftType":     swiftType,
+	"swiftValue":    swiftValue,
+	"swiftString":   swiftString,
+	"kotlinType":    kotlinType,
+	"kotlinValue":   kotlinValue,
+	"kotlinString":  kotlinString,
+}
+
+// parseCadenceType parses a FLIX parameter type, nil is returned for types the parser does not accept
+func parseCadenceType(cadenceType string) ast.Type {
+	t, errs := parser.ParseType(nil, []byte(cadenceType), parser.Config{})
+	if len(errs) > 0 {
+		return nil
+	}
+	return t
+}
+
+/*
+Maps Cadence types to the types of a binding language and to expressions that convert values to SDK cadence values.
+Simple types map nominal Cadence types to a type and a conversion format, the composite formats receive the
+mapped element types or the value expression followed by the converted element expressions.
+Element names are the variables naming an element inside the conversion, an empty optional element reuses the expression
+*/
+type typeMapping struct {
+	simple          map[string][2]string
+	fallbackType    string
+	fallbackValue   string
+	optionalType    string
+	arrayType       string
+	dictionaryType  string
+	optionalValue   string
+	arrayValue      string
+	dictionaryValue string
+	optionalElem    string
+	arrayElem       string
+	keyElem         string
+	valueElem       string
+}
+
+func (m typeMapping) typeOf(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		if simple, ok := m.simple[t.String()]; ok {
+			return simple[0]
+		}
+	case *ast.OptionalType:
+		return fmt.Sprintf(m.optionalType, m.typeOf(t.Type))
+	case *ast.VariableSizedType:
+		return fmt.Sprintf(m.arrayType, m.typeOf(t.Type))
+	case *ast.ConstantSizedType:
+		return fmt.Sprintf(m.arrayType, m.typeOf(t.Type))
+	case *ast.DictionaryType:
+		return fmt.Sprintf(m.dictionaryType, m.typeOf(t.KeyType), m.typeOf(t.ValueType))
+	}
+	return m.fallbackType
+}
+
+func (m typeMapping) valueOf(t ast.Type, expr string) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		if simple, ok := m.simple[t.String()]; ok {
+			return fmt.Sprintf(simple[1], expr)
+		}
+	case *ast.OptionalType:
+		elem := m.optionalElem
+		if elem == "" {
+			elem = expr
+		}
+		return fmt.Sprintf(m.optionalValue, expr, m.valueOf(t.Type, elem))
+	case *ast.VariableSizedType:
+		return fmt.Sprintf(m.arrayValue, expr, m.valueOf(t.Type, m.arrayElem))
+	case *ast.ConstantSizedType:
+		return fmt.Sprintf(m.arrayValue, expr, m.valueOf(t.Type, m.arrayElem))
+	case *ast.DictionaryType:
+		return fmt.Sprintf(m.dictionaryValue, expr, m.valueOf(t.KeyType, m.keyElem), m.valueOf(t.ValueType, m.valueElem))
+	}
+	return fmt.Sprintf(m.fallbackValue, expr)
+}
+
+// simpleTypes builds the simple type table of a mapping from a table of binding types, conversions
+// default to the constructor format with the Cadence type name
+func simpleTypes(types map[string]string, constructor string, overrides map[string]string) map[string][2]string {
+	simple := make(map[string][2]string)
+	for cadenceType, bindingType := range types {
+		value, ok := overrides[cadenceType]
+		if !ok {
+			value = strings.NewReplacer("{type}", cadenceType, "{lower}", strings.ToLower(cadenceType)).Replace(constructor)
+		}
+		simple[cadenceType] = [2]string{bindingType, value}
+	}
+	return simple
+}
+
+var pythonMapping = typeMapping{
+	simple: simpleTypes(map[string]string{
+		"Int": "int", "Int8": "int", "Int16": "int", "Int32": "int", "Int64": "int", "Int128": "int", "Int256": "int",
+		"UInt": "int", "UInt8": "int", "UInt16": "int", "UInt32": "int", "UInt64": "int", "UInt128": "int", "UInt256": "int",
+		"Word8": "int", "Word16": "int", "Word32": "int", "Word64": "int", "Word128": "int", "Word256": "int",
+		"Fix64": "Decimal", "UFix64": "Decimal",
+		"String": "str", "Character": "str", "Address": "str",
+		"Bool": "bool",
+	}, "cadence.{type}(%s)", map[string]string{
+		"Address": "cadence.Address.from_hex(%s)",
+	}),
+	fallbackType:    "cadence.Value",
+	fallbackValue:   "%s",
+	optionalType:    "%s | None",
+	arrayType:       "list[%s]",
+	dictionaryType:  "dict[%s, %s]",
+	optionalValue:   "cadence.Optional(None if %[1]s is None else %[2]s)",
+	arrayValue:      "cadence.Array([%[2]s for v in %[1]s])",
+	dictionaryValue: "cadence.Dictionary([cadence.KeyValuePair(%[2]s, %[3]s) for k, v in %[1]s.items()])",
+	arrayElem:       "v",
+	keyElem:         "k",
+	valueElem:       "v",
+}
+
+// pythonType returns the Python type hint of a Cadence type,
+// types without a native Python equivalent are passed as cadence.Value
+func pythonType(cadenceType string) string {
+	return pythonMapping.typeOf(parseCadenceType(cadenceType))
+}
+
+// pythonValue returns the flow-py-sdk expression that converts the Python expression to a Cadence value
+func pythonValue(cadenceType string, expr string) string {
+	return pythonMapping.valueOf(parseCadenceType(cadenceType), expr)
+}
+
+// pythonDecimal reports whether the type hint of a parameter uses Decimal, bindings only import it then
+func pythonDecimal(params []BindingParameter) bool {
+	for _, param := range params {
+		if strings.Contains(pythonType(param.Type), "Decimal") {
+			return true
+		}
+	}
+	return false
+}
+
+var swiftMapping = typeMapping{
+	simple: simpleTypes(map[string]string{
+		"Int": "Int", "Int8": "Int8", "Int16": "Int16", "Int32": "Int32", "Int64": "Int64", "Int128": "BigInt", "Int256": "BigInt",
+		"UInt": "UInt", "UInt8": "UInt8", "UInt16": "UInt16", "UInt32": "UInt32", "UInt64": "UInt64", "UInt128": "BigUInt", "UInt256": "BigUInt",
+		"Word8": "UInt8", "Word16": "UInt16", "Word32": "UInt32", "Word64": "UInt64",
+		"Fix64": "Decimal", "UFix64": "Decimal",
+		"String": "String", "Character": "String", "Address": "Flow.Address",
+		"Bool": "Bool",
+	}, ".{lower}(%s)", nil),
+	fallbackType:    "Flow.Cadence.FValue",
+	fallbackValue:   "%s",
+	optionalType:    "%s?",
+	arrayType:       "[%s]",
+	dictionaryType:  "[%s: %s]",
+	optionalValue:   ".optional(%[1]s.map { (element) -> Flow.Cadence.FValue in %[2]s })",
+	arrayValue:      ".array(%[1]s.map { (element) -> Flow.Cadence.FValue in %[2]s })",
+	dictionaryValue: ".dictionary(%[1]s.map { (key, value) in Flow.Argument.Dictionary(key: Flow.Argument(value: %[2]s), value: Flow.Argument(value: %[3]s)) })",
+	optionalElem:    "element",
+	arrayElem:       "element",
+	keyElem:         "key",
+	valueElem:       "value",
+}
+
+// swiftType returns the Swift type of a Cadence type for the Flow Swift SDK
+func swiftType(cadenceType string) string {
+	return swiftMapping.typeOf(parseCadenceType(cadenceType))
+}
+
+// swiftValue returns the Flow.Cadence.FValue expression of the Swift expression
+func swiftValue(cadenceType string, expr string) string {
+	return swiftMapping.valueOf(parseCadenceType(cadenceType), expr)
+}
+
+var kotlinMapping = typeMapping{
+	simple: simpleTypes(map[string]string{
+		"Int": "BigInteger", "Int8": "Byte", "Int16": "Short", "Int32": "Int", "Int64": "Long", "Int128": "BigInteger", "Int256": "BigInteger",
+		"UInt": "BigInteger", "UInt8": "UByte", "UInt16": "UShort", "UInt32": "UInt", "UInt64": "ULong", "UInt128": "BigInteger", "UInt256": "BigInteger",
+		"Word8": "UByte", "Word16": "UShort", "Word32": "UInt", "Word64": "ULong", "Word128": "BigInteger", "Word256": "BigInteger",
+		"Fix64": "BigDecimal", "UFix64": "BigDecimal",
+		"String": "String", "Character": "String", "Address": "String",
+		"Bool": "Boolean",
+	}, "{type}NumberField(%s.toString())", map[string]string{
+		"Fix64":     "Fix64NumberField(%s.toPlainString())",
+		"UFix64":    "UFix64NumberField(%s.toPlainString())",
+		"String":    "StringField(%s)",
+		"Character": "CharacterField(%s)",
+		"Address":   "AddressField(%s)",
+		"Bool":      "BooleanField(%s)",
+	}),
+	fallbackType:    "Field<*>",
+	fallbackValue:   "%s",
+	optionalType:    "%s?",
+	arrayType:       "List<%s>",
+	dictionaryType:  "Map<%s, %s>",
+	optionalValue:   "OptionalField(%[1]s?.let { %[2]s })",
+	arrayValue:      "ArrayField(%[1]s.map { %[2]s }.toTypedArray())",
+	dictionaryValue: "DictionaryField(%[1]s.map { DictionaryFieldEntry(%[2]s, %[3]s) }.toTypedArray())",
+	optionalElem:    "it",
+	arrayElem:       "it",
+	keyElem:         "it.key",
+	valueElem:       "it.value",
+}
+
+// kotlinType returns the Kotlin type of a Cadence type for the Flow JVM SDK
+func kotlinType(cadenceType string) string {
+	return kotlinMapping.typeOf(parseCadenceType(cadenceType))
+}
+
+// kotlinValue returns the JSON-Cadence field expression of the Kotlin expression
+func kotlinValue(cadenceType string, expr string) string {
+	return kotlinMapping.valueOf(parseCadenceType(cadenceType), expr)
+}
+
+// numeric Cadence types, fcl takes and returns their values as strings to keep the precision
+var cadenceNumberTypes = []string{
+	"Int", "Int8", "Int16", "Int32", "Int64", "Int128", "Int256",
+	"UInt", "UInt8", "UInt16", "UInt32", "UInt64", "UInt128", "UInt256",
+	"Word8", "Word16", "Word32", "Word64", "Word128", "Word256",
+	"Fix64", "UFix64",
+}
+
+var cadencePathTypes = []string{"Path", "StoragePath", "PublicPath", "PrivatePath", "CapabilityPath"}
+
+// built-in Cadence types that are not composites, fcl has no argument builder for them
+var cadenceAbstractTypes = []string{
+	"Any", "AnyStruct", "AnyResource", "Never", "Type", "Block", "Account", "Capability",
+	"Number", "SignedNumber", "Integer", "SignedInteger", "FixedPoint", "SignedFixedPoint",
+}
+
+const (
+	jsPathType      = "{ domain: string; identifier: string }"
+	jsCompositeType = "{ id: string; fields: Array<{ name: string; value: any }> }"
+)
+
+// jsType returns the TypeScript type of a Cadence type as fcl takes it as an argument
+func jsType(cadenceType string) string {
+	return jsTypeOf(parseCadenceType(cadenceType))
+}
+
+func jsTypeOf(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := t.String()
+		switch {
+		case slices.Contains(cadenceNumberTypes, name), name == "String", name == "Character", name == "Address":
+			return "string"
+		case name == "Bool":
+			return "boolean"
+		case name == "Void":
+			return "void"
+		case slices.Contains(cadencePathTypes, name):
+			return jsPathType
+		case !slices.Contains(cadenceAbstractTypes, name):
+			return jsCompositeType
+		}
+	case *ast.OptionalType:
+		return jsTypeOf(t.Type) + " | null"
+	case *ast.VariableSizedType:
+		return jsArrayType(t.Type)
+	case *ast.ConstantSizedType:
+		return jsArrayType(t.Type)
+	case *ast.DictionaryType:
+		key := jsTypeOf(t.KeyType)
+		if key != "string" {
+			// object keys are strings, other key types are converted when the argument is built
+			key = "string"
+		}
+		return fmt.Sprintf("Record<%s, %s>", key, jsTypeOf(t.ValueType))
+	}
+	return "any"
+}
+
+func jsArrayType(elem ast.Type) string {
+	return "Array<" + jsTypeOf(elem) + ">"
+}
+
+// fclType returns the fcl argument type builder of a Cadence type, without the leading t.
+// Types fcl cannot build are passed through with t.Identity and have to be JSON-Cadence already
+func fclType(cadenceType string) string {
+	return strings.TrimPrefix(fclTypeOf(parseCadenceType(cadenceType)), "t.")
+}
+
+func fclTypeOf(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := t.String()
+		switch {
+		case slices.Contains(cadenceNumberTypes, name), slices.Contains([]string{"String", "Character", "Address", "Bool", "Void"}, name):
+			return "t." + name
+		case slices.Contains(cadencePathTypes, name):
+			return "t.Path"
+		case !slices.Contains(cadenceAbstractTypes, name):
+			return "t.Struct"
+		}
+	case *ast.OptionalType:
+		return "t.Optional(" + fclTypeOf(t.Type) + ")"
+	case *ast.VariableSizedType:
+		return "t.Array(" + fclTypeOf(t.Type) + ")"
+	case *ast.ConstantSizedType:
+		return "t.Array(" + fclTypeOf(t.Type) + ")"
+	case *ast.DictionaryType:
+		return fmt.Sprintf("t.Dictionary({ key: %s, value: %s })", fclTypeOf(t.KeyType), fclTypeOf(t.ValueType))
+	}
+	return "t.Identity"
+}
+
+// jsArgValue returns the JavaScript expression fcl takes as the argument value of the expression,
+// dictionaries are typed as objects but fcl takes them as a list of key value pairs
+func jsArgValue(cadenceType string, expr string) string {
+	return jsArgValueOf(parseCadenceType(cadenceType), expr)
+}
+
+func jsArgValueOf(t ast.Type, expr string) string {
+	if !containsDictionary(t) {
+		return expr
+	}
+	switch t := t.(type) {
+	case *ast.OptionalType:
+		return fmt.Sprintf("%s == null ? null : %s", expr, jsArgValueOf(t.Type, expr))
+	case *ast.VariableSizedType:
+		return fmt.Sprintf("%s.map((v) => %s)", expr, jsArgValueOf(t.Type, "v"))
+	case *ast.ConstantSizedType:
+		return fmt.Sprintf("%s.map((v) => %s)", expr, jsArgValueOf(t.Type, "v"))
+	case *ast.DictionaryType:
+		key := "key"
+		if t.KeyType.String() == "Bool" {
+			key = `key === "true"`
+		}
+		value := jsArgValueOf(t.ValueType, "value")
+		if key == "key" && value == "value" {
+			return fmt.Sprintf("Object.entries(%s).map(([key, value]) => ({ key, value }))", expr)
+		}
+		return fmt.Sprintf("Object.entries(%s).map(([key, value]) => ({ key: %s, value: %s }))", expr, key, value)
+	}
+	return expr
+}
+
+func containsDictionary(t ast.Type) bool {
+	switch t := t.(type) {
+	case *ast.OptionalType:
+		return containsDictionary(t.Type)
+	case *ast.VariableSizedType:
+		return containsDictionary(t.Type)
+	case *ast.ConstantSizedType:
+		return containsDictionary(t.Type)
+	case *ast.DictionaryType:
+		return true
+	}
+	return false
+}
+
+// quoteString returns a double quoted string literal, unicodeFormat formats the code point of other control characters
+func quoteString(s string, escapes map[rune]string, unicodeFormat string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if escape, ok := escapes[r]; ok {
+			b.WriteString(escape)
+		} else if r < 0x20 {
+			fmt.Fprintf(&b, unicodeFormat, r)
+		} else {
+			b.WriteRune(r)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+var cStyleEscapes = map[rune]string{'"': `\"`, '\\': `\\`, '\n': `\n`, '\r': `\r`, '\t': `\t`}
+
+// pythonString returns a Python string literal
+func pythonString(s string) string {
+	return quoteString(s, cStyleEscapes, `\x%02x`)
+}
+
+// swiftString returns a Swift string literal
+func swiftString(s string) string {
+	return quoteString(s, cStyleEscapes, `\u{%x}`)
+}
+
+// kotlinString returns a Kotlin string literal, $ is escaped to prevent string templates
+func kotlinString(s string) string {
+	escapes := map[rune]string{'$': `\$`}
+	for r, escape := range cStyleEscapes {
+		escapes[r] = escape
+	}
+	return quoteString(s, escapes, `\u%04x`)
+}
+
+var importLinePattern = regexp.MustCompile(`(?m)^\s*import\b.*$`)
+
+// countAuthorizers returns the number of accounts the prepare block of a transaction takes,
+// imports are removed first since v1.0 templates import from placeholder addresses.
+// Transactions that cannot be parsed are assumed to have a single authorizer
+func countAuthorizers(code string) int {
+	program, err := parser.ParseProgram(nil, []byte(importLinePattern.ReplaceAllString(code, "")), parser.Config{})
+	if err != nil {
+		return 1
+	}
+	for _, tx := range program.TransactionDeclarations() {
+		if tx.Prepare == nil || tx.Prepare.FunctionDeclaration.ParameterList == nil {
+			return 0
+		}
+		return len(tx.Prepare.FunctionDeclaration.ParameterList.Parameters)
+	}
+	return 1
+}
+
+/*
+Go type of a Cadence type and the flow-go-sdk conversions to and from cadence values,
+Encode and Decode are format strings applied to the converted expression
+*/
+type goConversion struct {
+	GoType   string
+	Encode   string
+	Fallible bool
+	Decode   string
+}
+
+var goSimpleTypes = map[string]goConversion{
+	"Int":       {"*big.Int", "cadence.NewIntFromBig(%s)", false, "%s.Big()"},
+	"Int8":      {"int8", "cadence.NewInt8(%s)", false, "int8(%s)"},
+	"Int16":     {"int16", "cadence.NewInt16(%s)", false, "int16(%s)"},
+	"Int32":     {"int32", "cadence.NewInt32(%s)", false, "int32(%s)"},
+	"Int64":     {"int64", "cadence.NewInt64(%s)", false, "int64(%s)"},
+	"Int128":    {"*big.Int", "cadence.NewInt128FromBig(%s)", true, "%s.Big()"},
+	"Int256":    {"*big.Int", "cadence.NewInt256FromBig(%s)", true, "%s.Big()"},
+	"UInt":      {"*big.Int", "cadence.NewUIntFromBig(%s)", true, "%s.Big()"},
+	"UInt8":     {"uint8", "cadence.NewUInt8(%s)", false, "uint8(%s)"},
+	"UInt16":    {"uint16", "cadence.NewUInt16(%s)", false, "uint16(%s)"},
+	"UInt32":    {"uint32", "cadence.NewUInt32(%s)", false, "uint32(%s)"},
+	"UInt64":    {"uint64", "cadence.NewUInt64(%s)", false, "uint64(%s)"},
+	"UInt128":   {"*big.Int", "cadence.NewUInt128FromBig(%s)", true, "%s.Big()"},
+	"UInt256":   {"*big.Int", "cadence.NewUInt256FromBig(%s)", true, "%s.Big()"},
+	"Word8":     {"uint8", "cadence.NewWord8(%s)", false, "uint8(%s)"},
+	"Word16":    {"uint16", "cadence.NewWord16(%s)", false, "uint16(%s)"},
+	"Word32":    {"uint32", "cadence.NewWord32(%s)", false, "uint32(%s)"},
+	"Word64":    {"uint64", "cadence.NewWord64(%s)", false, "uint64(%s)"},
+	"Word128":   {"*big.Int", "cadence.NewWord128FromBig(%s)", true, "%s.Big()"},
+	"Word256":   {"*big.Int", "cadence.NewWord256FromBig(%s)", true, "%s.Big()"},
+	"Fix64":     {"string", "cadence.NewFix64(%s)", true, "%s.String()"},
+	"UFix64":    {"string", "cadence.NewUFix64(%s)", true, "%s.String()"},
+	"String":    {"string", "cadence.NewString(%s)", true, "string(%s)"},
+	"Character": {"string", "cadence.NewCharacter(%s)", true, "string(%s)"},
+	"Bool":      {"bool", "cadence.NewBool(%s)", false, "bool(%s)"},
+	"Address":   {"flow.Address", "cadence.NewAddress(%s)", false, "flow.Address(%s)"},
+}
+
+// names used by the generated functions that parameters must not shadow
+var goReservedNames = []string{"ctx", "client", "network", "code", "args", "arg", "err", "value", "result", "decoded", "tx"}
+
+// goName returns the Go identifier of a FLIX parameter label
+func goName(label string) string {
+	name := strcase.LowerCamelCase(label)
+	if token.IsKeyword(name) || slices.Contains(goReservedNames, name) {
+		return name + "Param"
+	}
+	return name
+}
+
+// goType returns the Go type of a Cadence type, types without a Go equivalent are passed as cadence.Value
+func goType(cadenceType string) string {
+	return goTypeOf(parseCadenceType(cadenceType))
+}
+
+func goTypeOf(t ast.Type) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		if conversion, ok := goSimpleTypes[t.String()]; ok {
+			return conversion.GoType
+		}
+	case *ast.OptionalType:
+		return "*" + goTypeOf(t.Type)
+	case *ast.VariableSizedType:
+		return "[]" + goTypeOf(t.Type)
+	case *ast.ConstantSizedType:
+		return "[]" + goTypeOf(t.Type)
+	case *ast.DictionaryType:
+		return "map[" + goTypeOf(t.KeyType) + "]" + goTypeOf(t.ValueType)
+	}
+	return "cadence.Value"
+}
+
+// goOutputType returns the Go type script results are decoded to, only simple types are decoded
+func goOutputType(cadenceType string) string {
+	if conversion, ok := goSimpleTypes[cadenceType]; ok {
+		return conversion.GoType
+	}
+	return "cadence.Value"
+}
+
+// goString returns a Go string literal, raw when possible to keep Cadence readable
+func goString(s string) string {
+	if strings.Contains(s, "`") {
+		return strconv.Quote(s)
+	}
+	return "`" + s + "`"
+}
+
+// goComment continues every line of text as a Go line comment
+func goComment(text string) string {
+	return strings.ReplaceAll(strings.TrimSpace(text), "\n", "\n// ")
+}
+
+/*
+Writes the statements that convert Go parameters to cadence values
+*/
+type goEncoder struct {
+	code     strings.Builder
+	fallible bool
+}
+
+// goEncodeArguments returns the body of a function that converts the parameters to cadence arguments
+func goEncodeArguments(params []BindingParameter) string {
+	var e goEncoder
+	for i, param := range params {
+		e.encode(parseCadenceType(param.Type), goName(param.Name), fmt.Sprintf("args[%d]", i), param.Name, 0)
+	}
+	var body strings.Builder
+	if e.fallible {
+		body.WriteString("var err error\n")
+	}
+	fmt.Fprintf(&body, "args := make([]cadence.Value, %d)\n", len(params))
+	body.WriteString(e.code.String())
+	body.WriteString("return args, nil")
+	return body.String()
+}
+
+func (e *goEncoder) encode(t ast.Type, expr string, target string, label string, depth int) {
+	value := fmt.Sprintf("value%d", depth)
+	switch t := t.(type) {
+	case *ast.NominalType:
+		if conversion, ok := goSimpleTypes[t.String()]; ok {
+			if !conversion.Fallible {
+				fmt.Fprintf(&e.code, "%s = %s\n", target, fmt.Sprintf(conversion.Encode, expr))
+				return
+			}
+			e.fallible = true
+			fmt.Fprintf(&e.code, "%s, err = %s\n", target, fmt.Sprintf(conversion.Encode, expr))
+			fmt.Fprintf(&e.code, "if err != nil {\nreturn nil, fmt.Errorf(\"invalid %s: %%w\", err)\n}\n", label)
+			return
+		}
+	case *ast.OptionalType:
+		fmt.Fprintf(&e.code, "%s = cadence.NewOptional(nil)\n", target)
+		fmt.Fprintf(&e.code, "if %s != nil {\nvar %s cadence.Value\n", expr, value)
+		e.encode(t.Type, "*"+expr, value, label, depth+1)
+		fmt.Fprintf(&e.code, "%s = cadence.NewOptional(%s)\n}\n", target, value)
+		return
+	case *ast.VariableSizedType:
+		e.encodeArray(t.Type, expr, target, label, depth)
+		return
+	case *ast.ConstantSizedType:
+		e.encodeArray(t.Type, expr, target, label, depth)
+		return
+	case *ast.DictionaryType:
+		pairs, key, elem := fmt.Sprintf("pairs%d", depth), fmt.Sprintf("key%d", depth), fmt.Sprintf("elem%d", depth)
+		fmt.Fprintf(&e.code, "%s := make([]cadence.KeyValuePair, 0, len(%s))\n", pairs, expr)
+		fmt.Fprintf(&e.code, "for %s, %s := range %s {\nvar pair cadence.KeyValuePair\n", key, elem, expr)
+		e.encode(t.KeyType, key, "pair.Key", label, depth+1)
+		e.encode(t.ValueType, elem, "pair.Value", label, depth+1)
+		fmt.Fprintf(&e.code, "%s = append(%s, pair)\n}\n", pairs, pairs)
+		fmt.Fprintf(&e.code, "%s = cadence.NewDictionary(%s)\n", target, pairs)
+		return
+	}
+	fmt.Fprintf(&e.code, "%s = %s\n", target, expr)
+}
+
+func (e *goEncoder) encodeArray(elemType ast.Type, expr string, target string, label string, depth int) {
+	values, i, elem := fmt.Sprintf("values%d", depth), fmt.Sprintf("i%d", depth), fmt.Sprintf("elem%d", depth)
+	fmt.Fprintf(&e.code, "%s := make([]cadence.Value, len(%s))\n", values, expr)
+	fmt.Fprintf(&e.code, "for %s, %s := range %s {\n", i, elem, expr)
+	e.encode(elemType, elem, fmt.Sprintf("%s[%s]", values, i), label, depth+1)
+	fmt.Fprintf(&e.code, "}\n%s = cadence.NewArray(%s)\n", target, values)
+}
+
+// goDecode returns the statements that decode a script result into the Go output type
+func goDecode(cadenceType string, value string, result string) string {
+	conversion, ok := goSimpleTypes[cadenceType]
+	if !ok {
+		return fmt.Sprintf("%s = %s", result, value)
+	}
+	return fmt.Sprintf("decoded, ok := %s.(cadence.%s)\n", value, cadenceType) +
+		fmt.Sprintf("if !ok {\nreturn %s, fmt.Errorf(\"unexpected script result type %%T\", %s)\n}\n", result, value) +
+		fmt.Sprintf("%s = %s", result, fmt.Sprintf(conversion.Decode, "decoded"))
+}
+
+// goImports returns the import specs a Go binding uses, the standard library before a blank line and the modules
+func goImports(data BindingData) []string {
+	var code strings.Builder
+	for _, param := range data.Parameters {
+		code.WriteString(goType(param.Type) + "\n")
+	}
+	if len(data.Parameters) > 0 {
+		code.WriteString(goEncodeArguments(data.Parameters) + "\n")
+	}
+	if data.IsScript {
+		code.WriteString(goOutputType(data.Output.Type) + "\n")
+		code.WriteString(goDecode(data.Output.Type, "value", "result") + "\n")
+	}
+	uses := func(pkg string) bool {
+		return strings.Contains(code.String(), pkg+".")
+	}
+
+	var std, modules []string
+	if data.IsScript {
+		std = append(std, `"context"`)
+	}
+	if len(data.NetworkCadence) > 0 || uses("fmt") {
+		std = append(std, `"fmt"`)
+	}
+	if uses("big") {
+		std = append(std, `"math/big"`)
+	}
+	if data.IsScript || len(data.Parameters) > 0 {
+		modules = append(modules, `"github.com/onflow/cadence"`)
+	}
+	if !data.IsScript || uses("flow") {
+		modules = append(modules, `flow "github.com/onflow/flow-go-sdk"`)
+	}
+	if data.IsScript {
+		modules = append(modules, `"github.com/onflow/flow-go-sdk/access"`)
+	}
+	if len(std) > 0 && len(modules) > 0 {
+		std = append(std, "")
+	}
+	return append(std, modules...)
+}
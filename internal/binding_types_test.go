@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPythonTypeMapping(t *testing.T) {
+	tests := []struct {
+		cadenceType string
+		pyType      string
+		pyValue     string
+	}{
+		{"UInt64", "int", "cadence.UInt64(x)"},
+		{"UFix64", "Decimal", "cadence.UFix64(x)"},
+		{"Address", "str", "cadence.Address.from_hex(x)"},
+		{"String?", "str | None", "cadence.Optional(None if x is None else cadence.String(x))"},
+		{"[[Int]]", "list[list[int]]", "cadence.Array([cadence.Array([cadence.Int(v) for v in v]) for v in x])"},
+		{"[Bool; 2]", "list[bool]", "cadence.Array([cadence.Bool(v) for v in x])"},
+		{"{String: UFix64}", "dict[str, Decimal]", "cadence.Dictionary([cadence.KeyValuePair(cadence.String(k), cadence.UFix64(v)) for k, v in x.items()])"},
+		{"StoragePath", "cadence.Value", "x"},
+		{"", "cadence.Value", "x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cadenceType, func(t *testing.T) {
+			assert.Equal(t, tt.pyType, pythonType(tt.cadenceType))
+			assert.Equal(t, tt.pyValue, pythonValue(tt.cadenceType, "x"))
+		})
+	}
+}
+
+func TestJsTypeMapping(t *testing.T) {
+	tests := []struct {
+		cadenceType string
+		jsType      string
+		fclType     string
+		jsArg       string
+	}{
+		{"UFix64", "string", "UFix64", "x"},
+		{"Bool", "boolean", "Bool", "x"},
+		{"Address?", "string | null", "Optional(t.Address)", "x"},
+		{"[[Int]]", "Array<Array<string>>", "Array(t.Array(t.Int))", "x"},
+		{"[UInt8; 32]", "Array<string>", "Array(t.UInt8)", "x"},
+		{"StoragePath", "{ domain: string; identifier: string }", "Path", "x"},
+		{"FungibleToken.Vault", "{ id: string; fields: Array<{ name: string; value: any }> }", "Struct", "x"},
+		{"&FungibleToken.Vault", "any", "Identity", "x"},
+		{"{String: UFix64}", "Record<string, string>", "Dictionary({ key: t.String, value: t.UFix64 })", "Object.entries(x).map(([key, value]) => ({ key, value }))"},
+		{"{Bool: [Int]}", "Record<string, Array<string>>", "Dictionary({ key: t.Bool, value: t.Array(t.Int) })", `Object.entries(x).map(([key, value]) => ({ key: key === "true", value: value }))`},
+		{"[{String: Int}?]", "Array<Record<string, string> | null>", "Array(t.Optional(t.Dictionary({ key: t.String, value: t.Int })))", "x.map((v) => v == null ? null : Object.entries(v).map(([key, value]) => ({ key, value })))"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cadenceType, func(t *testing.T) {
+			assert.Equal(t, tt.jsType, jsType(tt.cadenceType))
+			assert.Equal(t, tt.fclType, fclType(tt.cadenceType))
+			assert.Equal(t, tt.jsArg, jsArgValue(tt.cadenceType, "x"))
+		})
+	}
+}
+
+func TestCountAuthorizers(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal(0, countAuthorizers("transaction { execute {} }"))
+	assert.Equal(2, countAuthorizers("import \"A\"\ntransaction { prepare(a: &Account, b: &Account) {} }"))
+	assert.Equal(1, countAuthorizers("import A from 0xPLACEHOLDER\ntransaction(x: Int) { prepare(a: auth(Storage) &Account) {} }"))
+}
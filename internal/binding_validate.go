@@ -0,0 +1,115 @@
+package internal
+
+import (
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+)
+
+// jsValidate returns the JavaScript statement that validates a parameter before it is passed to fcl,
+// it is empty for types that are not validated. The statement calls the helpers of the validators template
+func jsValidate(cadenceType string, label string) string {
+	return jsValidateOf(parseCadenceType(cadenceType), strconv.Quote(label), label)
+}
+
+func jsValidateOf(t ast.Type, label string, expr string) string {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := t.String()
+		switch {
+		case name == "Address":
+			return fmt.Sprintf("validateAddress(%s, %s)", label, expr)
+		case name == "String", name == "Character":
+			return fmt.Sprintf("validateString(%s, %s)", label, expr)
+		case name == "Bool":
+			return fmt.Sprintf("validateBool(%s, %s)", label, expr)
+		case name == "Fix64", name == "UFix64":
+			return fmt.Sprintf("validateFixed(%s, %s, %q)", label, expr, name)
+		case slices.Contains(cadenceNumberTypes, name):
+			min, max := integerBounds(name)
+			return fmt.Sprintf("validateInt(%s, %s, %q, %s, %s)", label, expr, name, min, max)
+		}
+	case *ast.OptionalType:
+		if inner := jsValidateOf(t.Type, "l", "v"); inner != "" {
+			return fmt.Sprintf("validateOptional(%s, %s, %s)", label, expr, jsValidator(inner))
+		}
+	case *ast.VariableSizedType:
+		return jsValidateArray(t.Type, "null", label, expr)
+	case *ast.ConstantSizedType:
+		return jsValidateArray(t.Type, t.Size.Value.String(), label, expr)
+	case *ast.DictionaryType:
+		key := "null"
+		// object keys are strings, booleans and strings are not validated
+		if k := t.KeyType.String(); k != "Bool" && k != "String" && k != "Character" {
+			if inner := jsValidateOf(t.KeyType, "l", "v"); inner != "" {
+				key = jsValidator(inner)
+			}
+		}
+		value := "null"
+		if inner := jsValidateOf(t.ValueType, "l", "v"); inner != "" {
+			value = jsValidator(inner)
+		}
+		return fmt.Sprintf("validateDictionary(%s, %s, %s, %s)", label, expr, key, value)
+	}
+	return ""
+}
+
+func jsValidateArray(elem ast.Type, length string, label string, expr string) string {
+	if inner := jsValidateOf(elem, "l", "v"); inner != "" {
+		return fmt.Sprintf("validateArray(%s, %s, %s, %s)", label, expr, length, jsValidator(inner))
+	}
+	return fmt.Sprintf("validateArray(%s, %s, %s, null)", label, expr, length)
+}
+
+// jsValidator returns a validator function of a statement validating v with the label l,
+// helpers that only take the label and value are passed as they are
+func jsValidator(statement string) string {
+	if name, ok := strings.CutSuffix(statement, "(l, v)"); ok {
+		return name
+	}
+	return "(l, v) => " + statement
+}
+
+// integerBounds returns the smallest and largest value of an integer type as JavaScript string literals,
+// null is returned for bounds of Int and UInt since their size is not limited
+func integerBounds(name string) (string, string) {
+	signed := strings.HasPrefix(name, "Int")
+	width, err := strconv.Atoi(strings.TrimLeft(name, "UIntWord"))
+	if err != nil {
+		if signed {
+			return "null", "null"
+		}
+		return `"0"`, "null"
+	}
+	if signed {
+		max := new(big.Int).Lsh(big.NewInt(1), uint(width-1))
+		min := new(big.Int).Neg(max)
+		return strconv.Quote(min.String()), strconv.Quote(max.Sub(max, big.NewInt(1)).String())
+	}
+	max := new(big.Int).Lsh(big.NewInt(1), uint(width))
+	return `"0"`, strconv.Quote(max.Sub(max, big.NewInt(1)).String())
+}
+
+// names the validators template declares, bundles share one copy of them between all templates
+var jsValidatorHelpers = []string{
+	"Validator", "describeValue", "validateAddress", "validateString", "validateBool", "validateFixed",
+	"validateInt", "validateOptional", "validateArray", "validateDictionary",
+}
+
+var validatorPattern = regexp.MustCompile(`\bvalidate[A-Z]\w*`)
+
+// jsValidators returns the helpers the validation statements of the parameters call
+func jsValidators(params []BindingParameter) map[string]bool {
+	validators := make(map[string]bool)
+	for _, param := range params {
+		for _, name := range validatorPattern.FindAllString(param.JsValidate, -1) {
+			validators[name] = true
+		}
+	}
+	return validators
+}
@@ -0,0 +1,47 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestJsValidate(t *testing.T) {
+	tests := []struct {
+		cadenceType string
+		validate    string
+	}{
+		{"Address", `validateAddress("x", x)`},
+		{"String", `validateString("x", x)`},
+		{"Bool", `validateBool("x", x)`},
+		{"UFix64", `validateFixed("x", x, "UFix64")`},
+		{"Int", `validateInt("x", x, "Int", null, null)`},
+		{"UInt", `validateInt("x", x, "UInt", "0", null)`},
+		{"Int8", `validateInt("x", x, "Int8", "-128", "127")`},
+		{"Word16", `validateInt("x", x, "Word16", "0", "65535")`},
+		{"UInt256", `validateInt("x", x, "UInt256", "0", "115792089237316195423570985008687907853269984665640564039457584007913129639935")`},
+		{"Address?", `validateOptional("x", x, validateAddress)`},
+		{"[String]", `validateArray("x", x, null, validateString)`},
+		{"[StoragePath; 3]", `validateArray("x", x, 3, null)`},
+		{"[[Int16]]", `validateArray("x", x, null, (l, v) => validateArray(l, v, null, (l, v) => validateInt(l, v, "Int16", "-32768", "32767")))`},
+		{"{String: Bool}", `validateDictionary("x", x, null, validateBool)`},
+		{"{UInt8: UFix64?}", `validateDictionary("x", x, (l, v) => validateInt(l, v, "UInt8", "0", "255"), (l, v) => validateOptional(l, v, (l, v) => validateFixed(l, v, "UFix64")))`},
+		{"StoragePath", ""},
+		{"AnyStruct?", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.cadenceType, func(t *testing.T) {
+			assert.Equal(t, tt.validate, jsValidate(tt.cadenceType, "x"))
+		})
+	}
+}
+
+func TestJsValidators(t *testing.T) {
+	validators := jsValidators([]BindingParameter{
+		newSimpleParameter("owner", "Address?", ""),
+		newSimpleParameter("path", "StoragePath", ""),
+		newSimpleParameter("amounts", "[UFix64]", ""),
+	})
+	assert.Equal(t, map[string]bool{"validateOptional": true, "validateAddress": true, "validateArray": true, "validateFixed": true}, validators)
+	assert.Empty(t, jsValidators(nil))
+}
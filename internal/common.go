@@ -4,8 +4,13 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"go/token"
 	"net/url"
 	"path/filepath"
+	"strings"
+	"unicode"
+
+	"github.com/onflow/cadence/ast"
 )
 
 func getTemplateVersion(template string) (string, error) {
@@ -26,13 +31,15 @@ func getTemplateVersion(template string) (string, error) {
 	return flowTemplate.FVersion, nil
 }
 
-func isArrayParameter(arg FlixParameter) (isArray bool, cType string, jsType string) {
-	if arg.Type == "" || arg.Type[0] != '[' {
-		return false, "", ""
+// arrayElementType returns the element type of array types, other types are returned as is
+func arrayElementType(cadenceType string) string {
+	switch t := parseCadenceType(cadenceType).(type) {
+	case *ast.VariableSizedType:
+		return t.Type.String()
+	case *ast.ConstantSizedType:
+		return t.Type.String()
 	}
-	cadenceType := arg.Type[1 : len(arg.Type)-1]
-	javascriptType := "Array<" + convertCadenceTypeToJS(cadenceType) + ">"
-	return true, cadenceType, javascriptType
+	return cadenceType
 }
 
 func isUrl(str string) bool {
@@ -102,3 +109,18 @@ func getRelativePath(configFile, bindingFile string) (string, error) {
 	// Currently binding files are js, we need to convert the path to unix style
 	return filepath.ToSlash(relPath), nil
 }
+
+// bindingPackageName derives the package of a generated Go or Kotlin binding from its destination directory
+func bindingPackageName(destFile string) string {
+	name := strings.ToLower(filepath.Base(filepath.Dir(destFile)))
+	name = strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_' {
+			return r
+		}
+		return -1
+	}, name)
+	if name == "" || !token.IsIdentifier(name) {
+		return "bindings"
+	}
+	return name
+}
@@ -1,5 +1,9 @@
 package common
 
+import (
+	"github.com/onflow/flow-go-sdk"
+)
+
 // Logger interface for consistent logging across packages
 type Logger interface {
 	Debug(string)
@@ -12,4 +16,45 @@ type NetworkConfig struct {
 	Name string
 	Host string
 	Key  string
+	// PinBlockHeight is the sealed block height dependencies are pinned at,
+	// zero pins at the latest sealed block
+	PinBlockHeight uint64
+}
+
+// ResolveOptions adjusts how template imports are resolved on a network
+type ResolveOptions struct {
+	// NetworkAliases maps a network name to the template network it is resolved as, e.g. local to emulator
+	NetworkAliases map[string]string
+	// ContractOverrides maps contract names to the address used on the resolved network,
+	// taking precedence over the template and filling in contracts it is missing
+	ContractOverrides map[string]string
+}
+
+// ResolveNetwork returns the template network a network name is resolved as
+func (o ResolveOptions) ResolveNetwork(networkName string) string {
+	if alias, ok := o.NetworkAliases[networkName]; ok {
+		return alias
+	}
+	return networkName
+}
+
+// AppliedOverride records a contract override used while resolving imports,
+// TemplateAddress is empty when the template has no address for the contract on the network
+type AppliedOverride struct {
+	Contract        string
+	Address         string
+	TemplateAddress string
+}
+
+// NewAppliedOverride records an override with both addresses normalized to 0x prefixed 16 digit hex,
+// so the same override is reported alike for every template version
+func NewAppliedOverride(contract string, address string, templateAddress string) AppliedOverride {
+	override := AppliedOverride{
+		Contract: contract,
+		Address:  flow.HexToAddress(address).HexWithPrefix(),
+	}
+	if templateAddress != "" {
+		override.TemplateAddress = flow.HexToAddress(templateAddress).HexWithPrefix()
+	}
+	return override
 }
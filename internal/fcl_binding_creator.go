@@ -2,9 +2,13 @@ package internal
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"go/format"
 	"sort"
+	"strings"
 	"text/template"
+	"time"
 
 	"github.com/onflow/flixkit-go/v2/internal/templates"
 	v1 "github.com/onflow/flixkit-go/v2/internal/v1"
@@ -19,6 +23,44 @@ func NewFclTSCreator() *FclCreator {
 		templates.GetTsFclTxTemplate(),
 		templates.GetTsFclParamsTemplate(),
 		templates.GetTsFclInterfaceTemplate(),
+		templates.GetFclBundleTemplate(),
+	}
+
+	return &FclCreator{
+		templates:     t,
+		fileExtension: "ts",
+		typed:         true,
+	}
+}
+
+// NewReactCreator creates TypeScript bindings with the functions of NewFclTSCreator and a React hook per template,
+// script hooks hold the result with loading and error state and transaction hooks send it and follow its status
+func NewReactCreator() *FclCreator {
+	// the TypeScript main template defines the function and is replaced by the React main template
+	t := []string{
+		templates.GetTsFclMainTemplate(),
+		templates.GetReactMainTemplate(),
+		templates.GetTsFclScriptTemplate(),
+		templates.GetTsFclTxTemplate(),
+		templates.GetTsFclParamsTemplate(),
+		templates.GetTsFclInterfaceTemplate(),
+		templates.GetReactHookTemplate(),
+	}
+
+	return &FclCreator{
+		templates: t,
+		typed:     true,
+	}
+}
+
+// NewPythonCreator creates bindings that build and send scripts and transactions with flow-py-sdk
+func NewPythonCreator() *FclCreator {
+	t := []string{
+		templates.GetPyMainTemplate(),
+		templates.GetPyResolveTemplate(),
+		templates.GetPyScriptTemplate(),
+		templates.GetPyTxTemplate(),
+		templates.GetPyParamsTemplate(),
 	}
 
 	return &FclCreator{
@@ -26,87 +68,297 @@ func NewFclTSCreator() *FclCreator {
 	}
 }
 
+// NewGoCreator creates bindings that build transactions and execute scripts with flow-go-sdk,
+// the Cadence of every network is embedded so the binding has to be regenerated when the template changes
+func NewGoCreator(packageName string) *FclCreator {
+	t := []string{
+		templates.GetGoMainTemplate(),
+		templates.GetGoCadenceTemplate(),
+		templates.GetGoScriptTemplate(),
+		templates.GetGoTxTemplate(),
+		templates.GetGoParamsTemplate(),
+	}
+
+	return &FclCreator{
+		templates:   t,
+		packageName: packageName,
+		format:      formatGo,
+	}
+}
+
+// NewSwiftCreator creates bindings for the Flow Swift SDK with a parameter struct and an async function per template,
+// the Cadence of every network is embedded so the binding has to be regenerated when the template changes
+func NewSwiftCreator() *FclCreator {
+	t := []string{
+		templates.GetSwiftMainTemplate(),
+		templates.GetSwiftScriptTemplate(),
+		templates.GetSwiftTxTemplate(),
+		templates.GetSwiftParamsTemplate(),
+	}
+
+	return &FclCreator{
+		templates: t,
+	}
+}
+
+// NewKotlinCreator creates bindings for the Flow JVM SDK with a parameter data class and a suspend function per template,
+// the Cadence of every network is embedded so the binding has to be regenerated when the template changes
+func NewKotlinCreator(packageName string) *FclCreator {
+	t := []string{
+		templates.GetKotlinMainTemplate(),
+		templates.GetKotlinScriptTemplate(),
+		templates.GetKotlinTxTemplate(),
+		templates.GetKotlinParamsTemplate(),
+	}
+
+	return &FclCreator{
+		templates:   t,
+		packageName: packageName,
+	}
+}
+
+// formatGo formats generated Go code, the main template imports exactly the packages the binding uses
+func formatGo(code string) (string, error) {
+	formatted, err := format.Source([]byte(code))
+	if err != nil {
+		return code, fmt.Errorf("could not format generated go code: %w", err)
+	}
+	return string(formatted), nil
+}
+
 func NewFclJSCreator() *FclCreator {
 	t := []string{
 		templates.GetJsFclMainTemplate(),
 		templates.GetJsFclScriptTemplate(),
 		templates.GetJsFclTxTemplate(),
 		templates.GetJsFclParamsTemplate(),
+		templates.GetFclBundleTemplate(),
 	}
 
 	return &FclCreator{
-		templates: t,
+		templates:     t,
+		fileExtension: "js",
 	}
 }
 
+/*
+Options of binding creation, Embed inlines the template in bindings that load it at runtime
+after verifying its id matches its content, the other bindings only verify the id
+*/
+type BindingOptions struct {
+	Embed bool
+	// Numbers is the type JavaScript and TypeScript bindings decode numbers of script results and events to
+	Numbers NumberType
+	// TxResults adds a function to JavaScript and TypeScript transaction bindings that waits for the transaction
+	// to be sealed and decodes the events declared by the imported contracts in Contracts
+	TxResults bool
+	// Contracts is the Cadence code of dependency contracts by name, such as the code of their pins
+	Contracts map[string]string
+	// GeneratedAt is recorded in the binding header, the zero time leaves it out so bindings are deterministic
+	GeneratedAt time.Time
+}
+
 func (g *FclCreator) Create(flixString string, templateLocation string) (string, error) {
+	return g.CreateWithOptions(flixString, templateLocation, BindingOptions{})
+}
+
+func (g *FclCreator) CreateWithOptions(flixString string, templateLocation string, opts BindingOptions) (string, error) {
 	tmpl, err := parseTemplates(g.templates)
 	if err != nil {
 		return "", err
 	}
+	data, err := g.newBindingData(flixString, templateLocation, opts)
+	if err != nil {
+		return "", err
+	}
+	if opts.Embed {
+		data.EmbeddedTemplate, err = embedTemplate(flixString)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	data.PackageName = g.packageName
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, data)
+	if err != nil || g.format == nil {
+		return buf.String(), err
+	}
+	return g.format(buf.String())
+}
+
+// newBindingData parses a template of any supported version into the data binding templates are executed with
+func (g *FclCreator) newBindingData(flixString string, templateLocation string, opts BindingOptions) (BindingData, error) {
 	if flixString == "" {
-		return "", fmt.Errorf("no flix template provided")
+		return BindingData{}, fmt.Errorf("no flix template provided")
 	}
 
 	ver, err := getTemplateVersion(flixString)
 	if err != nil {
-		return "", fmt.Errorf("invalid flix template version, %w", err)
+		return BindingData{}, fmt.Errorf("invalid flix template version, %w", err)
 	}
 
 	isLocal := !isUrl(templateLocation)
-	var data templateData
+	var data BindingData
 	switch ver {
 	case "1.0.0":
 
 		flix, err := v1.ParseFlix(flixString)
 		if err != nil {
-			return "", err
+			return BindingData{}, err
 		}
 		data = getTemplateDataV1_0(flix, templateLocation, isLocal)
+		data.code = flix.Data.Cadence
 	case "1.1.0":
 		flix, err := v1_1.ParseFlix(flixString)
 		if err != nil {
-			return "", err
+			return BindingData{}, err
 		}
 		data = getTemplateDataV1_1(flix, templateLocation, isLocal)
+		data.code = flix.Data.Cadence.Body
 	default:
-		return "", fmt.Errorf("invalid flix template version: %s", ver)
+		return BindingData{}, fmt.Errorf("invalid flix template version: %s", ver)
 	}
 
 	data.FclVersion = GetFlixFclCompatibility(ver)
+	data.TemplateVar = "flixTemplate"
+	data.Validators = jsValidators(data.Parameters)
+	data.setProvenance(opts.GeneratedAt)
+	data.decodeOutput(opts.Numbers, "", g.typed)
+	if opts.TxResults {
+		if err := data.decodeEvents(opts.Numbers, opts.Contracts, g.typed); err != nil {
+			return BindingData{}, err
+		}
+	}
+	return data, nil
+}
+
+// embedTemplate returns the indented template after verifying its id matches its content,
+// ids can only be computed for v1.1 templates
+func embedTemplate(flixString string) (string, error) {
+	ver, err := getTemplateVersion(flixString)
+	if err != nil {
+		return "", fmt.Errorf("invalid flix template version, %w", err)
+	}
+	if ver != "1.1.0" {
+		return "", fmt.Errorf("template version %s cannot be embedded, only ids of 1.1.0 templates can be verified", ver)
+	}
+	flix, err := v1_1.ParseFlix(flixString)
+	if err != nil {
+		return "", err
+	}
+	id, err := v1_1.GenerateFlixID(flix)
+	if err != nil {
+		return "", fmt.Errorf("could not compute template id: %w", err)
+	}
+	if id != flix.ID {
+		return "", fmt.Errorf("template id %s does not match the template content, computed id %s", flix.ID, id)
+	}
 	var buf bytes.Buffer
-	err = tmpl.Execute(&buf, data)
-	return buf.String(), err
+	err = json.Indent(&buf, []byte(strings.TrimSpace(flixString)), "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
 }
 
-type simpleParameter struct {
-	Name        string
+/*
+Parameter or script output of a template as binding templates receive it
+*/
+type BindingParameter struct {
+	// Name is the label of the parameter in the Cadence code
+	Name string
+	// Type is the full Cadence type, CadType is the element type of arrays and the full type otherwise.
+	// Type is empty for the output of scripts that do not declare one
+	Type string
+	// JsType is the TypeScript type of the parameter
 	JsType      string
 	Description string
-	FclType     string
-	CadType     string
+	// FclType is the fcl argument type builder without the leading t.,
+	// JsArg converts the parameter to the value fcl takes for it
+	FclType string
+	JsArg   string
+	// JsValidate is the statement validating the parameter before it is passed to fcl, it is empty
+	// for types that are not validated and throws an error naming the parameter for invalid values
+	JsValidate string
+	CadType    string
+}
+
+/*
+Cadence of a template with imports replaced by the contract addresses of the network
+*/
+type BindingNetworkCadence struct {
+	Network string
+	Cadence string
 }
 
-type templateData struct {
-	FclVersion           string
-	Version              string
-	Parameters           []simpleParameter
+/*
+Data binding templates are executed with, built-in and custom templates receive the same data.
+Fields are only ever added so custom templates keep working with later versions
+*/
+type BindingData struct {
+	// FclVersion is the lowest fcl version that supports the template version
+	FclVersion string
+	// Version is the FLIX version of the template
+	Version string
+	// Parameters are ordered by index
+	Parameters []BindingParameter
+	// ParametersPrefixName is the title in upper camel case, Title is the title in lower camel case
 	ParametersPrefixName string
-	Output               simpleParameter
-	Title                string
-	Description          string
-	Location             string
-	IsScript             bool
-	IsLocalTemplate      bool
+	// Output is the script result, it is empty for transactions. Its type is inferred from the return type of main
+	// when the template does not declare it and JsType is the type OutputDecode decodes the fcl result in info to
+	Output       BindingParameter
+	OutputDecode string
+	// OutputInterfaces are the structs the output is decoded to and the events and structs of transaction results
+	OutputInterfaces []BindingInterface
+	// DecimalImport is set when the output or events decode numbers to Decimal of decimal.js
+	DecimalImport bool
+	// Validators are the validation helpers the JsValidate statements of the parameters call
+	Validators map[string]bool
+	// TxResults adds the transaction function waiting for the transaction to be sealed, it decodes Events
+	TxResults   bool
+	Events      []BindingEvent
+	Title       string
+	Description string
+	// Location is the url of the template or its path relative to the binding file when IsLocalTemplate is set
+	Location        string
+	IsScript        bool
+	IsLocalTemplate bool
+	// Authorizers is the number of accounts the transaction prepare block takes
+	Authorizers int
+	// NetworkCadence is the Cadence with imports replaced for each network the template can be resolved on,
+	// Cadence is only set for templates without dependencies
+	NetworkCadence []BindingNetworkCadence
+	Cadence        string
+	// PackageName is the package of Go and Kotlin bindings derived from the destination directory
+	PackageName string
+	// TemplateVar is the JavaScript variable holding the template, unique for every template of a bundle
+	TemplateVar string
+	// ExportTypes exports the parameter interfaces so bundles can share them
+	ExportTypes bool
+	// ID is the id of the template, it is recorded in the header with the network pins, the flixkit version
+	// and the generation time when it is set, see ProvenanceLines
+	ID             string
+	NetworkPins    []BindingNetworkPin
+	FlixkitVersion string
+	GeneratedAt    string
+	// EmbeddedTemplate is the indented template json when it is embedded, its id was verified
+	EmbeddedTemplate string
+	// code is the template Cadence before imports are replaced
+	code string
 }
 
 type FclCreator struct {
 	templates []string
-}
-
-type FlixParameter struct {
-	Name string
-	Type string
+	// packageName is the package of generated Go and Kotlin bindings
+	packageName string
+	// format post-processes generated code, nil leaves it unchanged
+	format func(code string) (string, error)
+	// fileExtension of bundle modules, creators without one do not support bundles
+	fileExtension string
+	// typed creators emit TypeScript, their decoders annotate callback parameters for strict mode
+	// and their bundles share the parameter interfaces of all templates in a types module
+	typed bool
 }
 
 func GetFlixFclCompatibility(flixVersion string) string {
@@ -123,12 +375,12 @@ func GetFlixFclCompatibility(flixVersion string) string {
 	return v
 }
 
-func getTemplateDataV1_1(flix *v1_1.InteractionTemplate, templateLocation string, isLocal bool) templateData {
+func getTemplateDataV1_1(flix *v1_1.InteractionTemplate, templateLocation string, isLocal bool) BindingData {
 	var msgs v1_1.InteractionTemplateMessages = flix.Data.Messages
 	title := msgs.GetTitle("Request")
 	methodName := strcase.LowerCamelCase(title)
 	description := msgs.GetDescription("")
-	var sp simpleParameter
+	var sp BindingParameter
 
 	if flix.Data.Type == "script" {
 		oTemp := flix.Data.Output
@@ -144,8 +396,13 @@ func getTemplateDataV1_1(flix *v1_1.InteractionTemplate, templateLocation string
 		if len(o) > 0 {
 			sp = o[0]
 		}
+		if flix.Data.Output == nil {
+			// the output type is unknown
+			sp.Type = ""
+		}
 	}
-	data := templateData{
+	data := BindingData{
+		ID:                   flix.ID,
 		Version:              flix.FVersion,
 		Parameters:           transformParameters(flix.Data.Parameters),
 		ParametersPrefixName: strcase.UpperCamelCase(title),
@@ -156,22 +413,30 @@ func getTemplateDataV1_1(flix *v1_1.InteractionTemplate, templateLocation string
 		IsScript:             flix.IsScript(),
 		IsLocalTemplate:      isLocal,
 	}
+	if flix.IsTransaction() {
+		data.Authorizers = countAuthorizers(flix.Data.Cadence.Body)
+	}
+	for _, pin := range flix.Data.Cadence.NetworkPins {
+		data.NetworkPins = append(data.NetworkPins, BindingNetworkPin{Network: pin.Network, Pin: pin.PinSelf})
+	}
+	data.NetworkCadence, data.Cadence = resolveNetworkCadence(flix.Data.Cadence.Body, flix.Networks(), flix.ReplaceCadenceImports)
 	return data
 }
 
-func getTemplateDataV1_0(flix *v1.FlowInteractionTemplate, templateLocation string, isLocal bool) templateData {
+func getTemplateDataV1_0(flix *v1.FlowInteractionTemplate, templateLocation string, isLocal bool) BindingData {
 	title := flix.Data.Messages.GetTitleValue("Request")
 	methodName := strcase.LowerCamelCase(title)
 	description := flix.GetDescription()
-	var sp simpleParameter
+	var sp BindingParameter
 	// version 1.0 does not support output parameters, add default output
 	if flix.Data.Type == "script" {
-		sp = simpleParameter{
+		sp = BindingParameter{
 			Name:   "result",
 			JsType: "string",
 		}
 	}
-	data := templateData{
+	data := BindingData{
+		ID:                   flix.ID,
 		Version:              flix.FVersion,
 		Parameters:           transformArguments(flix.Data.Arguments),
 		ParametersPrefixName: strcase.UpperCamelCase(title),
@@ -182,31 +447,36 @@ func getTemplateDataV1_0(flix *v1.FlowInteractionTemplate, templateLocation stri
 		IsLocalTemplate:      isLocal,
 		Output:               sp,
 	}
+	if flix.IsTransaction() {
+		data.Authorizers = countAuthorizers(flix.Data.Cadence)
+	}
+	data.NetworkCadence, data.Cadence = resolveNetworkCadence(flix.Data.Cadence, flix.Networks(), flix.ReplaceCadenceImports)
 	return data
 }
 
-func convertCadenceTypeToJS(cadenceType string) string {
-	// need to determine js type based on fcl supported types
-	// looking at fcl types and how arguments work as parameters
-	// https://github.com/onflow/fcl-js/blob/master/packages/types/src/types.js
-	switch cadenceType {
-	case "Bool":
-		return "boolean"
-	case "Void":
-		return "void"
-	case "Dictionary":
-		return "object"
-	case "Struct":
-		return "object"
-	case "Enum":
-		return "object"
-	default:
-		return "string"
+// resolveNetworkCadence replaces imports for every network, networks missing a contract are left out.
+// Templates without networks have no dependencies and the same Cadence everywhere,
+// the body is kept as is when its imports cannot be replaced without a network
+func resolveNetworkCadence(body string, networks []string, replace func(network string) (string, error)) ([]BindingNetworkCadence, string) {
+	if len(networks) == 0 {
+		cadence, err := replace("")
+		if err != nil {
+			return nil, body
+		}
+		return nil, cadence
 	}
+	var resolved []BindingNetworkCadence
+	for _, network := range networks {
+		cadence, err := replace(network)
+		if err == nil {
+			resolved = append(resolved, BindingNetworkCadence{Network: network, Cadence: cadence})
+		}
+	}
+	return resolved, ""
 }
 
 func parseTemplates(templates []string) (*template.Template, error) {
-	baseTemplate := template.New("base")
+	baseTemplate := template.New("base").Funcs(bindingFuncs)
 
 	for _, tmplStr := range templates {
 		_, err := baseTemplate.Parse(tmplStr)
@@ -218,8 +488,8 @@ func parseTemplates(templates []string) (*template.Template, error) {
 	return baseTemplate, nil
 }
 
-func transformParameters(args []v1_1.Parameter) []simpleParameter {
-	simpleArgs := []simpleParameter{}
+func transformParameters(args []v1_1.Parameter) []BindingParameter {
+	simpleArgs := []BindingParameter{}
 	if len(args) == 0 {
 		return simpleArgs
 	}
@@ -228,21 +498,14 @@ func transformParameters(args []v1_1.Parameter) []simpleParameter {
 	})
 
 	for _, arg := range args {
-		isArray, cType, jsType := isArrayParameter(FlixParameter{Name: arg.Label, Type: arg.Type})
 		var msgs v1_1.InteractionTemplateMessages = arg.Messages
-		desciption := msgs.GetDescription("")
-		if isArray {
-			simpleArgs = append(simpleArgs, simpleParameter{Name: arg.Label, CadType: cType, JsType: jsType, FclType: "Array(t." + cType + ")", Description: desciption})
-		} else {
-			jsType := convertCadenceTypeToJS(arg.Type)
-			simpleArgs = append(simpleArgs, simpleParameter{Name: arg.Label, CadType: arg.Type, JsType: jsType, FclType: arg.Type, Description: desciption})
-		}
+		simpleArgs = append(simpleArgs, newSimpleParameter(arg.Label, arg.Type, msgs.GetDescription("")))
 	}
 	return simpleArgs
 }
 
-func transformArguments(args v1.Arguments) []simpleParameter {
-	simpleArgs := []simpleParameter{}
+func transformArguments(args v1.Arguments) []BindingParameter {
+	simpleArgs := []BindingParameter{}
 	var keys []string
 	// get keys for sorting
 	for k := range args {
@@ -254,14 +517,21 @@ func transformArguments(args v1.Arguments) []simpleParameter {
 	})
 	for _, key := range keys {
 		arg := args[key]
-		isArray, cType, jsType := isArrayParameter(FlixParameter{Name: key, Type: arg.Type})
-		desciption := arg.Messages.GetTitleValue("")
-		if isArray {
-			simpleArgs = append(simpleArgs, simpleParameter{Name: key, CadType: cType, JsType: jsType, FclType: "Array(t." + cType + ")", Description: desciption})
-		} else {
-			jsType := convertCadenceTypeToJS(arg.Type)
-			simpleArgs = append(simpleArgs, simpleParameter{Name: key, CadType: arg.Type, JsType: jsType, FclType: arg.Type, Description: desciption})
-		}
+		simpleArgs = append(simpleArgs, newSimpleParameter(key, arg.Type, arg.Messages.GetTitleValue("")))
 	}
 	return simpleArgs
 }
+
+// newSimpleParameter maps a FLIX parameter to the binding types of each language
+func newSimpleParameter(name string, cadenceType string, description string) BindingParameter {
+	return BindingParameter{
+		Name:        name,
+		Type:        cadenceType,
+		CadType:     arrayElementType(cadenceType),
+		JsType:      jsType(cadenceType),
+		FclType:     fclType(cadenceType),
+		JsArg:       jsArgValue(cadenceType, name),
+		JsValidate:  jsValidate(cadenceType, name),
+		Description: description,
+	}
+}
@@ -597,3 +597,328 @@ func TestBindingReadTokenBalance(t *testing.T) {
 	assert.NoError(err, "ParseTemplate should not return an error")
 	autogold.ExpectFile(t, out)
 }
+
+func TestPyGenParamsScript(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewPythonCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestPyGenParamsTx(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewPythonCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestPyGenMultipleAuthorizersTx(t *testing.T) {
+	template := *minimumParamTemplateTS_TX
+	template.Data.Cadence = v1_1.Cadence{
+		Body: "import \"HelloWorld\"\ntransaction(greeting: String) {\n  prepare(first: &Account, second: &Account) {}\n  execute { HelloWorld.updateGreeting(newGreeting: greeting) }\n}\n",
+	}
+	ttemp, err := json.Marshal(&template)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewPythonCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(t, err, "ParseTemplate should not return an error")
+	assert.NotContains(t, out, "from decimal import Decimal", "Decimal is only imported for Decimal parameters")
+	autogold.ExpectFile(t, out)
+}
+
+func TestPyGenTransactionV1(t *testing.T) {
+	ttemp, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewPythonCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "https://flix.flow.com/v1/templates?name=transfer-flow")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestPyGenReadTokenBalance(t *testing.T) {
+	generator := NewPythonCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(ReadTokenScript, "./read-token-balance.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestGoGenParamsScript(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewGoCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestGoGenTransactionV1(t *testing.T) {
+	ttemp, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewGoCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "https://flix.flow.com/v1/templates?name=transfer-flow")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestGoGenReadTokenBalance(t *testing.T) {
+	generator := NewGoCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(ReadTokenScript, "./read-token-balance.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestGoGenNoParamsTx(t *testing.T) {
+	flix := &v1_1.InteractionTemplate{
+		FType:    "InteractionTemplate",
+		FVersion: "1.1.0",
+		ID:       "290b6b6222b2a77b16db896a80ddf29ebd1fa3038c9e6625a933fa213fce51fa",
+		Data: v1_1.Data{
+			Type: "transaction",
+			Cadence: v1_1.Cadence{
+				Body: "transaction { prepare(acct: &Account) { log(acct.address) } }",
+			},
+		},
+	}
+	ttemp, err := json.Marshal(flix)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewGoCreator("bindings").Create(string(ttemp), "./log.template.json")
+	assert.NoError(t, err, "Create should not return an error")
+	// only the packages the binding uses are imported
+	assert.Contains(t, out, "import (\n\tflow \"github.com/onflow/flow-go-sdk\"\n)")
+	autogold.ExpectFile(t, out)
+}
+
+func TestBindingPackageName(t *testing.T) {
+	assert := assert.New(t)
+	assert.Equal("bindings", bindingPackageName(""))
+	assert.Equal("flix", bindingPackageName("./pkg/flix/transfer.go"))
+	assert.Equal("mybindings", bindingPackageName("/src/my-bindings/transfer.go"))
+}
+
+var complexParamTemplate = &v1_1.InteractionTemplate{
+	FType:    "InteractionTemplate",
+	FVersion: "1.1.0",
+	ID:       "290b6b6222b2a77b16db896a80ddf29ebd1fa3038c9e6625a933fa213fce51fa",
+	Data: v1_1.Data{
+		Type: "script",
+		Cadence: v1_1.Cadence{
+			Body: "access(all) fun main(matrix: [[Int]], balances: {String: UFix64}, owner: Address?, path: StoragePath): UFix64 { return 0.0 }",
+		},
+		Parameters: []v1_1.Parameter{
+			{Label: "matrix", Index: 0, Type: "[[Int]]"},
+			{Label: "balances", Index: 1, Type: "{String: UFix64}"},
+			{Label: "owner", Index: 2, Type: "Address?"},
+			{Label: "path", Index: 3, Type: "StoragePath"},
+		},
+		Output: &v1_1.Parameter{Label: "result", Type: "UFix64"},
+	},
+}
+
+func TestTSGenComplexParams(t *testing.T) {
+	ttemp, err := json.Marshal(complexParamTemplate)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewFclTSCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./complex.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestGoGenComplexParams(t *testing.T) {
+	ttemp, err := json.Marshal(complexParamTemplate)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewGoCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./complex.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestSwiftGenParamsTx(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewSwiftCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestSwiftGenReadTokenBalance(t *testing.T) {
+	generator := NewSwiftCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(ReadTokenScript, "./read-token-balance.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestSwiftGenComplexParams(t *testing.T) {
+	ttemp, err := json.Marshal(complexParamTemplate)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewSwiftCreator()
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./complex.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestKotlinGenParamsTx(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewKotlinCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./min.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestKotlinGenReadTokenBalance(t *testing.T) {
+	generator := NewKotlinCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(ReadTokenScript, "./read-token-balance.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestKotlinGenComplexParams(t *testing.T) {
+	ttemp, err := json.Marshal(complexParamTemplate)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	generator := NewKotlinCreator("bindings")
+	assert := assert.New(t)
+
+	out, err := generator.Create(string(ttemp), "./complex.template.json")
+	assert.NoError(err, "ParseTemplate should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+// verifiedTemplate returns the template json with the id computed from its content
+func verifiedTemplate(t *testing.T, flix *v1_1.InteractionTemplate) string {
+	verified := *flix
+	id, err := v1_1.GenerateFlixID(&verified)
+	assert.NoError(t, err, "GenerateFlixID should not return an error")
+	verified.ID = id
+	ttemp, err := json.Marshal(verified)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+	return string(ttemp)
+}
+
+func TestTSGenEmbeddedTemplate(t *testing.T) {
+	assert := assert.New(t)
+	out, err := NewFclTSCreator().CreateWithOptions(verifiedTemplate(t, minimumParamTemplateTS_TX), "https://flix.flow.com/v1/templates?name=update-greeting", BindingOptions{Embed: true})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	// the source is only recorded in the header, the template is not fetched
+	assert.NotContains(out, `const flixTemplate = "https://flix.flow.com`)
+	assert.Contains(out, "flix-source: https://flix.flow.com/v1/templates?name=update-greeting")
+	autogold.ExpectFile(t, out)
+}
+
+func TestPyGenEmbeddedTemplate(t *testing.T) {
+	assert := assert.New(t)
+	out, err := NewPythonCreator().CreateWithOptions(verifiedTemplate(t, minimumParamTemplateTS_SCRIPT), "./min.template.json", BindingOptions{Embed: true})
+	assert.NoError(err, "CreateWithOptions should not return an error")
+	// the source is only recorded in the header, the template is not read
+	assert.NotContains(out, `Path(__file__).parent / "./min.template.json"`)
+	assert.Contains(out, "flix-source: ./min.template.json")
+	autogold.ExpectFile(t, out)
+}
+
+func TestEmbedTemplateErrors(t *testing.T) {
+	assert := assert.New(t)
+
+	modified := *minimumParamTemplateTS_TX
+	modified.ID = "0000000000000000000000000000000000000000000000000000000000000000"
+	ttemp, err := json.Marshal(modified)
+	assert.NoError(err, "marshal template to json should not return an error")
+	_, err = NewFclJSCreator().CreateWithOptions(string(ttemp), "./min.template.json", BindingOptions{Embed: true})
+	assert.ErrorContains(err, "does not match the template content")
+	_, err = NewGoCreator("bindings").CreateWithOptions(string(ttemp), "./min.template.json", BindingOptions{Embed: true})
+	assert.ErrorContains(err, "does not match the template content", "bindings embedding cadence also verify the id")
+
+	ttemp, err = json.Marshal(parsedTemplateTX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	_, err = NewFclTSCreator().CreateWithOptions(string(ttemp), "./transfer_token.json", BindingOptions{Embed: true})
+	assert.ErrorContains(err, "cannot be embedded")
+}
+
+func TestReactGenParamsScript(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewReactCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(t, err, "Create should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestReactGenArrayParamsScript(t *testing.T) {
+	template := *minimumParamTemplateTS_SCRIPT
+	template.Data.Cadence = v1_1.Cadence{
+		Body: "access(all) fun main(addresses: [Address], amounts: {String: UFix64}): Int {\n  return addresses.length + amounts.length\n}\n",
+	}
+	template.Data.Parameters = []v1_1.Parameter{
+		{Label: "addresses", Index: 0, Type: "[Address]"},
+		{Label: "amounts", Index: 1, Type: "{String: UFix64}"},
+	}
+	ttemp, err := json.Marshal(&template)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewReactCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(t, err, "Create should not return an error")
+	// inline arrays and objects are new on every render, the hook depends on their content
+	assert.Contains(t, out, "}, [paramsKey, runs]);")
+	autogold.ExpectFile(t, out)
+}
+
+func TestReactGenNoParamsScript(t *testing.T) {
+	ttemp, err := json.Marshal(minimumNoParamTemplateTS_SCRIPT)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewReactCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(t, err, "Create should not return an error")
+	autogold.ExpectFile(t, out)
+}
+
+func TestReactGenParamsTx(t *testing.T) {
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(t, err, "marshal template to json should not return an error")
+
+	out, err := NewReactCreator().Create(string(ttemp), "./min.template.json")
+	assert.NoError(t, err, "Create should not return an error")
+	autogold.ExpectFile(t, out)
+}
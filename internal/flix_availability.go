@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/onflow/flixkit-go/v2/internal/v1"
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+/*
+Whether a template can be resolved on a network, listing everything that is missing at once
+*/
+type NetworkAvailability struct {
+	Network string
+	// MissingContracts are imported contracts without an address on the network
+	MissingContracts []string
+	// HasNetworkPin is always false for v1.0 templates, which have no network pins
+	HasNetworkPin bool
+	// MissingDependencyPins are dependency contracts without a pin on the network
+	MissingDependencyPins []string
+}
+
+// Resolvable is true when imports can be replaced for the network
+func (a NetworkAvailability) Resolvable() bool {
+	return len(a.MissingContracts) == 0
+}
+
+// Pinned is true when the template can be resolved and verified with pins on the network
+func (a NetworkAvailability) Pinned() bool {
+	return a.Resolvable() && a.HasNetworkPin && len(a.MissingDependencyPins) == 0
+}
+
+func (s flixService) GetTemplateAvailability(ctx context.Context, templateName string) ([]NetworkAvailability, error) {
+	template, _, err := s.GetTemplate(ctx, templateName)
+	if err != nil {
+		return nil, err
+	}
+	return TemplateAvailability(template)
+}
+
+// TemplateAvailability reports for each network a template mentions whether it can be resolved there
+func TemplateAvailability(template string) ([]NetworkAvailability, error) {
+	ver, err := getTemplateVersion(template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flix template version, %w", err)
+	}
+	var report []NetworkAvailability
+	switch ver {
+	case "1.1.0":
+		flix, err := v1_1.ParseFlix(template)
+		if err != nil {
+			return nil, err
+		}
+		for _, network := range flix.Networks() {
+			report = append(report, NetworkAvailability{
+				Network:               network,
+				MissingContracts:      flix.MissingContracts(network),
+				HasNetworkPin:         flix.NetworkPin(network) != "",
+				MissingDependencyPins: flix.MissingDependencyPins(network),
+			})
+		}
+	case "1.0.0":
+		flix, err := v1.ParseFlix(template)
+		if err != nil {
+			return nil, err
+		}
+		for _, network := range flix.Networks() {
+			report = append(report, NetworkAvailability{
+				Network:               network,
+				MissingContracts:      flix.MissingContracts(network),
+				MissingDependencyPins: flix.MissingDependencyPins(network),
+			})
+		}
+	default:
+		return nil, fmt.Errorf("flix template version: %s not supported", ver)
+	}
+	return report, nil
+}
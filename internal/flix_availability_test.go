@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTemplateAvailabilityV1_1(t *testing.T) {
+	assert := assert.New(t)
+
+	flixService := NewFlixService(&FlixServiceConfig{})
+	report, err := flixService.GetTemplateAvailability(context.Background(), ReadTokenScript)
+	assert.NoError(err, "GetTemplateAvailability should not return an error")
+	assert.Equal([]NetworkAvailability{
+		{
+			Network:               "emulator",
+			HasNetworkPin:         false,
+			MissingDependencyPins: []string{"FlowToken", "FungibleToken"},
+		},
+		{
+			Network:       "mainnet",
+			HasNetworkPin: true,
+		},
+		{
+			Network:       "testnet",
+			HasNetworkPin: true,
+		},
+	}, report)
+	assert.True(report[0].Resolvable())
+	assert.False(report[0].Pinned())
+	assert.True(report[1].Pinned())
+}
+
+func TestTemplateAvailabilityMissingContracts(t *testing.T) {
+	assert := assert.New(t)
+
+	template := `{
+		"f_type": "InteractionTemplate",
+		"f_version": "1.1.0",
+		"data": {
+			"type": "script",
+			"cadence": {
+				"body": "import \"FungibleToken\"\nimport \"FlowToken\"\nimport Crypto\naccess(all) fun main(): Void {}",
+				"network_pins": [{"network": "mainnet", "pin_self": "abc"}]
+			},
+			"dependencies": [
+				{"contracts": [{"contract": "FungibleToken", "networks": [{"network": "testnet", "address": "0x9a0766d93b6608b7"}]}]}
+			]
+		}
+	}`
+	report, err := TemplateAvailability(template)
+	assert.NoError(err, "TemplateAvailability should not return an error")
+	assert.Equal([]NetworkAvailability{
+		{
+			Network:          "mainnet",
+			MissingContracts: []string{"FungibleToken", "FlowToken"},
+			HasNetworkPin:    true,
+		},
+		{
+			Network:               "testnet",
+			MissingContracts:      []string{"FlowToken"},
+			MissingDependencyPins: []string{"FungibleToken"},
+		},
+	}, report)
+	assert.False(report[0].Resolvable())
+}
+
+func TestTemplateAvailabilityV1_0(t *testing.T) {
+	assert := assert.New(t)
+
+	report, err := TemplateAvailability(flix_template)
+	assert.NoError(err, "TemplateAvailability should not return an error")
+	assert.Equal([]NetworkAvailability{
+		{Network: "mainnet"},
+		{Network: "testnet"},
+	}, report)
+}
@@ -0,0 +1,143 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/onflow/flixkit-go/v2/internal/common"
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+/*
+List of Cadence files to create templates for, relative paths are resolved against the manifest location
+*/
+type BatchManifest struct {
+	Templates []BatchEntry `json:"templates"`
+}
+
+/*
+Cadence file with an optional pre-filled template, output defaults to the Cadence file name with a .template.json extension
+*/
+type BatchEntry struct {
+	Cadence string `json:"cadence"`
+	PreFill string `json:"pre_fill,omitempty"`
+	Output  string `json:"output,omitempty"`
+}
+
+/*
+Outcome of creating a single template of a batch, Error is set when the template could not be created or written
+*/
+type BatchResult struct {
+	Cadence string
+	Output  string
+	ID      string
+	Error   error
+}
+
+func ParseBatchManifest(manifest []byte) (*BatchManifest, error) {
+	var m BatchManifest
+	err := json.Unmarshal(manifest, &m)
+	if err != nil {
+		return nil, err
+	}
+	for i, entry := range m.Templates {
+		if entry.Cadence == "" {
+			return nil, fmt.Errorf("template %d in manifest has no cadence file", i)
+		}
+	}
+	return &m, nil
+}
+
+func (s flixService) CreateTemplates(ctx context.Context, deployedContracts ContractInfos, manifestPath string, networks []common.NetworkConfig) ([]BatchResult, error) {
+	if s.config.FileReader == nil {
+		return nil, fmt.Errorf("file reader not provided")
+	}
+	if s.config.FileWriter == nil {
+		return nil, fmt.Errorf("file writer not provided")
+	}
+	file, err := s.config.FileReader.ReadFile(manifestPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read manifest %s: %w", manifestPath, err)
+	}
+	manifest, err := ParseBatchManifest(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse manifest %s: %w", manifestPath, err)
+	}
+
+	// one generator for the whole batch so all templates are pinned at the same heights
+	gen, err := s.newTemplateGenerator(deployedContracts, networks)
+	if err != nil {
+		return nil, err
+	}
+	err = gen.ShareDependencyPins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseDir := filepath.Dir(manifestPath)
+	results := make([]BatchResult, 0, len(manifest.Templates))
+	for _, entry := range manifest.Templates {
+		result := BatchResult{
+			Cadence: resolveManifestPath(baseDir, entry.Cadence),
+			Output:  resolveManifestPath(baseDir, entry.Output),
+		}
+		if entry.Output == "" {
+			result.Output = strings.TrimSuffix(result.Cadence, filepath.Ext(result.Cadence)) + ".template.json"
+		}
+		result.ID, result.Error = s.createBatchTemplate(ctx, gen, baseDir, entry, result)
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func (s flixService) createBatchTemplate(ctx context.Context, gen *v1_1.Generator, baseDir string, entry BatchEntry, result BatchResult) (string, error) {
+	code, err := s.config.FileReader.ReadFile(result.Cadence)
+	if err != nil {
+		return "", fmt.Errorf("could not read cadence file %s: %w", result.Cadence, err)
+	}
+
+	var preFill string
+	if entry.PreFill != "" {
+		// pre-fill can be a file next to the manifest or any other template query
+		query := entry.PreFill
+		if p := resolveManifestPath(baseDir, entry.PreFill); isPath(p, s.config.FileReader) {
+			query = p
+		}
+		preFill, _, err = s.GetTemplate(ctx, query)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	template, err := gen.CreateTemplate(ctx, string(code), preFill)
+	if err != nil {
+		return "", err
+	}
+	flix, err := v1_1.ParseFlix(template)
+	if err != nil {
+		return "", err
+	}
+
+	if dirs, ok := s.config.FileWriter.(DirMaker); ok {
+		if err := dirs.MkdirAll(filepath.Dir(result.Output), os.FileMode(0755)); err != nil {
+			return flix.ID, fmt.Errorf("could not create directory of template %s: %w", result.Output, err)
+		}
+	}
+	err = s.config.FileWriter.WriteFile(result.Output, []byte(template), os.FileMode(0644))
+	if err != nil {
+		return flix.ID, fmt.Errorf("could not write template %s: %w", result.Output, err)
+	}
+	return flix.ID, nil
+}
+
+func resolveManifestPath(baseDir string, path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(baseDir, path)
+}
@@ -0,0 +1,128 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+type memoryFiles map[string][]byte
+
+func (m memoryFiles) ReadFile(path string) ([]byte, error) {
+	data, ok := m[path]
+	if !ok {
+		return nil, fmt.Errorf("file %s not found", path)
+	}
+	return data, nil
+}
+
+func (m memoryFiles) WriteFile(path string, data []byte, perm os.FileMode) error {
+	m[path] = data
+	return nil
+}
+
+/*
+FileReader, FileWriter and DirMaker of the local file system
+*/
+type osFiles struct{}
+
+func (osFiles) ReadFile(path string) ([]byte, error) {
+	return os.ReadFile(path)
+}
+
+func (osFiles) WriteFile(path string, data []byte, perm os.FileMode) error {
+	return os.WriteFile(path, data, perm)
+}
+
+func (osFiles) MkdirAll(path string, perm os.FileMode) error {
+	return os.MkdirAll(path, perm)
+}
+
+func TestCreateTemplates(t *testing.T) {
+	assert := assert.New(t)
+	files := memoryFiles{
+		"project/flix.manifest.json": []byte(`{
+			"templates": [
+				{"cadence": "cadence/greeting.cdc", "pre_fill": "prefill/greeting.json", "output": "flix/greeting.json"},
+				{"cadence": "cadence/hello.cdc"},
+				{"cadence": "cadence/missing.cdc"}
+			]
+		}`),
+		"project/cadence/greeting.cdc": []byte(`
+			import "HelloWorld"
+
+			access(all)
+			fun main(): String {
+				return HelloWorld.greeting
+			}
+		`),
+		"project/prefill/greeting.json": []byte(`{
+			"f_type": "InteractionTemplate",
+			"f_version": "1.1.0",
+			"data": {
+				"messages": [{"key": "title", "i18n": [{"tag": "en-US", "translation": "Greeting"}]}]
+			}
+		}`),
+		"project/cadence/hello.cdc": []byte(`
+			access(all)
+			fun main(): String {
+				return "Hello"
+			}
+		`),
+	}
+	contracts := ContractInfos{
+		"HelloWorld": {"testnet": "0xee82856bf20e2aa6"},
+	}
+
+	flixService := NewFlixService(&FlixServiceConfig{FileReader: files, FileWriter: files})
+	results, err := flixService.CreateTemplates(context.Background(), contracts, "project/flix.manifest.json", nil)
+	assert.NoError(err, "CreateTemplates should not return an error")
+	assert.Len(results, 3)
+
+	assert.NoError(results[0].Error)
+	assert.Equal("project/flix/greeting.json", results[0].Output)
+	greeting, err := v1_1.ParseFlix(string(files["project/flix/greeting.json"]))
+	assert.NoError(err, "written template should parse")
+	assert.Equal(results[0].ID, greeting.ID)
+	var msgs v1_1.InteractionTemplateMessages = greeting.Data.Messages
+	assert.Equal("Greeting", msgs.GetTitle(""), "pre-filled messages should be kept")
+
+	assert.NoError(results[1].Error)
+	assert.Equal("project/cadence/hello.template.json", results[1].Output)
+	assert.Contains(files, "project/cadence/hello.template.json")
+
+	assert.Error(results[2].Error, "missing cadence file should be reported per template")
+	assert.Empty(results[2].ID)
+}
+
+func TestCreateTemplatesWithoutWriter(t *testing.T) {
+	flixService := NewFlixService(&FlixServiceConfig{FileReader: memoryFiles{}})
+	_, err := flixService.CreateTemplates(context.Background(), nil, "flix.manifest.json", nil)
+	assert.Error(t, err, "CreateTemplates should require a file writer")
+}
+
+func TestCreateTemplatesIntoNewDirectory(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "flix.manifest.json")
+	assert.NoError(os.WriteFile(manifest, []byte(`{"templates": [{"cadence": "hello.cdc", "output": "flix/hello.json"}]}`), 0644))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "hello.cdc"), []byte(`
+		access(all)
+		fun main(): String {
+			return "Hello"
+		}
+	`), 0644))
+
+	flixService := NewFlixService(&FlixServiceConfig{FileReader: osFiles{}, FileWriter: osFiles{}})
+	results, err := flixService.CreateTemplates(context.Background(), nil, manifest, nil)
+	assert.NoError(err, "CreateTemplates should not return an error")
+	assert.Len(results, 1)
+	assert.NoError(results[0].Error)
+	assert.FileExists(filepath.Join(dir, "flix", "hello.json"))
+}
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser"
+)
+
+/*
+Problem found in a template, Rule is a stable name of the check that found it
+*/
+type LintIssue struct {
+	Rule    string
+	Message string
+}
+
+const (
+	// LintMissingMessage is a template without a title or description
+	LintMissingMessage = "missing-message"
+	// LintParameterMessage is a parameter without a title
+	LintParameterMessage = "parameter-message"
+	// LintParameterMismatch is a parameter the template and its Cadence declare differently
+	LintParameterMismatch = "parameter-mismatch"
+	// LintInvalidCadence is Cadence of a 1.1.0 template the Cadence parser does not accept
+	LintInvalidCadence = "invalid-cadence"
+	// LintUnresolvable is a network an imported contract has no address on
+	LintUnresolvable = "unresolvable-network"
+	// LintMissingPin is a network without a network pin or a dependency without a pin
+	LintMissingPin = "missing-pin"
+)
+
+// LintTemplate checks a v1.0 or v1.1 template for missing messages, parameters that do not match the Cadence
+// and networks it cannot be resolved or verified on. Cadence of 1.0.0 templates predates Cadence 1.0 and is not parsed
+func LintTemplate(template string) ([]LintIssue, error) {
+	t, err := toDiffTemplate(template)
+	if err != nil {
+		return nil, err
+	}
+	availability, err := TemplateAvailability(template)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues []LintIssue
+	for _, key := range []string{"title", "description"} {
+		if len(t.messages[key]) == 0 {
+			issues = append(issues, LintIssue{Rule: LintMissingMessage, Message: fmt.Sprintf("template has no %s", key)})
+		}
+	}
+	labels := sortedParameterLabels(t.parameters)
+	for _, label := range labels {
+		if len(t.parameters[label].messages["title"]) == 0 {
+			issues = append(issues, LintIssue{Rule: LintParameterMessage, Message: fmt.Sprintf("parameter %s has no title", label)})
+		}
+	}
+	if t.version == "1.1.0" {
+		issues = append(issues, lintParameters(t, labels)...)
+	}
+	for _, network := range availability {
+		if !network.Resolvable() {
+			issues = append(issues, LintIssue{Rule: LintUnresolvable, Message: fmt.Sprintf("network %s has no address for %s", network.Network, strings.Join(network.MissingContracts, ", "))})
+		}
+		if t.version == "1.1.0" && !network.HasNetworkPin {
+			issues = append(issues, LintIssue{Rule: LintMissingPin, Message: fmt.Sprintf("network %s has no network pin", network.Network)})
+		}
+		if len(network.MissingDependencyPins) > 0 {
+			issues = append(issues, LintIssue{Rule: LintMissingPin, Message: fmt.Sprintf("network %s has no dependency pin for %s", network.Network, strings.Join(network.MissingDependencyPins, ", "))})
+		}
+	}
+	return issues, nil
+}
+
+// sortedParameterLabels returns the parameter labels ordered by index
+func sortedParameterLabels(parameters map[string]diffParameter) []string {
+	labels := make([]string, 0, len(parameters))
+	for label := range parameters {
+		labels = append(labels, label)
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		return parameters[labels[i]].index < parameters[labels[j]].index
+	})
+	return labels
+}
+
+// lintParameters compares the template parameters with the parameters of the transaction or the main function
+func lintParameters(t *diffTemplate, labels []string) []LintIssue {
+	program, err := parser.ParseProgram(nil, []byte(importLinePattern.ReplaceAllString(t.cadence, "")), parser.Config{})
+	if err != nil {
+		return []LintIssue{{Rule: LintInvalidCadence, Message: fmt.Sprintf("cadence cannot be parsed: %s", err)}}
+	}
+	var parameters []*ast.Parameter
+	for _, tx := range program.TransactionDeclarations() {
+		if tx.ParameterList != nil {
+			parameters = tx.ParameterList.Parameters
+		}
+	}
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier == "main" && function.ParameterList != nil {
+			parameters = function.ParameterList.Parameters
+		}
+	}
+
+	var issues []LintIssue
+	for i, parameter := range parameters {
+		name := parameter.Identifier.Identifier
+		cadType := parameter.TypeAnnotation.Type.String()
+		p, ok := t.parameters[name]
+		switch {
+		case !ok:
+			issues = append(issues, LintIssue{Rule: LintParameterMismatch, Message: fmt.Sprintf("parameter %s is not in the template", name)})
+		case p.cadType != cadType:
+			issues = append(issues, LintIssue{Rule: LintParameterMismatch, Message: fmt.Sprintf("parameter %s has type %s in the template and %s in the cadence", name, p.cadType, cadType)})
+		case p.index != i:
+			issues = append(issues, LintIssue{Rule: LintParameterMismatch, Message: fmt.Sprintf("parameter %s has index %d in the template and %d in the cadence", name, p.index, i)})
+		}
+	}
+	for _, label := range labels {
+		found := false
+		for _, parameter := range parameters {
+			found = found || parameter.Identifier.Identifier == label
+		}
+		if !found {
+			issues = append(issues, LintIssue{Rule: LintParameterMismatch, Message: fmt.Sprintf("parameter %s is not in the cadence", label)})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,64 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+func TestLintTemplate(t *testing.T) {
+	assert := assert.New(t)
+	ttemp, err := json.Marshal(minimumParamTemplateTS_TX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	issues, err := LintTemplate(string(ttemp))
+	assert.NoError(err)
+	assert.Empty(issues)
+
+	mismatched := *minimumParamTemplateTS_TX
+	mismatched.Data.Messages = mismatched.Data.Messages[:1]
+	mismatched.Data.Parameters = []v1_1.Parameter{
+		{Label: "greeting", Index: 1, Type: "String"},
+		{Label: "extra", Index: 0, Type: "Int"},
+	}
+	ttemp, err = json.Marshal(&mismatched)
+	assert.NoError(err, "marshal template to json should not return an error")
+	issues, err = LintTemplate(string(ttemp))
+	assert.NoError(err)
+	assert.Equal([]LintIssue{
+		{Rule: LintMissingMessage, Message: "template has no description"},
+		{Rule: LintParameterMessage, Message: "parameter extra has no title"},
+		{Rule: LintParameterMessage, Message: "parameter greeting has no title"},
+		{Rule: LintParameterMismatch, Message: "parameter greeting has index 1 in the template and 0 in the cadence"},
+		{Rule: LintParameterMismatch, Message: "parameter extra is not in the cadence"},
+	}, issues)
+
+	ttemp, err = json.Marshal(minimumParamTemplateTS_SCRIPT)
+	assert.NoError(err, "marshal template to json should not return an error")
+	issues, err = LintTemplate(string(ttemp))
+	assert.NoError(err)
+	assert.Contains(issues, LintIssue{Rule: LintMissingMessage, Message: "template has no title"})
+	assert.Equal(LintInvalidCadence, issues[len(issues)-1].Rule)
+}
+
+func TestLintTemplateNetworks(t *testing.T) {
+	assert := assert.New(t)
+	issues, err := LintTemplate(ReadTokenScript)
+	assert.NoError(err)
+	var rules []string
+	for _, issue := range issues {
+		rules = append(rules, issue.Rule)
+	}
+	assert.NotContains(rules, LintUnresolvable)
+
+	v1Template, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	issues, err = LintTemplate(string(v1Template))
+	assert.NoError(err)
+	assert.Empty(issues, "cadence of 1.0.0 templates is not parsed")
+
+	_, err = LintTemplate("{}")
+	assert.Error(err)
+}
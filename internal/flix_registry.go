@@ -0,0 +1,325 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+// ErrTemplateNotFound is returned by template stores when no template has the name or id
+var ErrTemplateNotFound = errors.New("template not found")
+
+/*
+Storage of a template registry, templates are stored after their id and network pins were verified.
+A name refers to the latest template stored with it
+*/
+type TemplateStore interface {
+	// TemplateByID returns the raw template with the id or ErrTemplateNotFound
+	TemplateByID(ctx context.Context, id string) (string, error)
+	// TemplateByName returns the raw template last stored with the name or ErrTemplateNotFound
+	TemplateByName(ctx context.Context, name string) (string, error)
+	// PutTemplate stores a template with its id, an empty name stores it by id only
+	PutTemplate(ctx context.Context, name string, id string, template string) error
+}
+
+var templateNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+
+// ValidateRegistryTemplate checks a template can be served by a registry and returns its id,
+// only 1.1.0 templates are accepted since their id and network pins can be verified
+func ValidateRegistryTemplate(template string) (string, error) {
+	flix, err := v1_1.ParseFlix(template)
+	if err != nil {
+		return "", fmt.Errorf("invalid template: %w", err)
+	}
+	if flix.FType != "InteractionTemplate" {
+		return "", fmt.Errorf("invalid template type %s, expected InteractionTemplate", flix.FType)
+	}
+	if flix.Data.Cadence.Body == "" {
+		return "", fmt.Errorf("template has no cadence")
+	}
+	verification, err := VerifyTemplate(template)
+	if err != nil {
+		return "", err
+	}
+	if verification.ID != verification.ComputedID {
+		return "", fmt.Errorf("template id %s does not match its content, computed %s", verification.ID, verification.ComputedID)
+	}
+	for _, pin := range verification.NetworkPins {
+		if !pin.Verified() {
+			return "", fmt.Errorf("network pin of %s does not match the cadence", pin.Network)
+		}
+	}
+	return verification.ID, nil
+}
+
+func validateTemplateName(name string) error {
+	if name != "" && !templateNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid template name %s, names are letters, digits, dots, dashes and underscores", name)
+	}
+	return nil
+}
+
+/*
+TemplateStore keeping templates in memory
+*/
+type MemoryStore struct {
+	mu        sync.RWMutex
+	templates map[string]string
+	names     map[string]string
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		templates: make(map[string]string),
+		names:     make(map[string]string),
+	}
+}
+
+func (s *MemoryStore) TemplateByID(_ context.Context, id string) (string, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	template, ok := s.templates[strings.ToLower(id)]
+	if !ok {
+		return "", ErrTemplateNotFound
+	}
+	return template, nil
+}
+
+func (s *MemoryStore) TemplateByName(ctx context.Context, name string) (string, error) {
+	s.mu.RLock()
+	id, ok := s.names[name]
+	s.mu.RUnlock()
+	if !ok {
+		return "", ErrTemplateNotFound
+	}
+	return s.TemplateByID(ctx, id)
+}
+
+func (s *MemoryStore) PutTemplate(_ context.Context, name string, id string, template string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	id = strings.ToLower(id)
+	s.templates[id] = template
+	if name != "" {
+		s.names[name] = id
+	}
+	return nil
+}
+
+// dirStoreNames is the file of a DirStore indexing template ids by name
+const dirStoreNames = "names.json"
+
+/*
+TemplateStore of a directory of json templates. New templates are written as <id>.json and the name they were
+stored with is indexed in names.json, so names and ids survive restarts. Templates added to the directory by hand
+are named after their file name without the .json extension. Templates are verified and indexed when the store is created
+*/
+type DirStore struct {
+	dir    string
+	memory *MemoryStore
+	mu     sync.Mutex
+	names  map[string]string
+}
+
+// NewDirStore loads the templates of a directory, it fails listing every file that is not a valid template
+// and every name of names.json that is invalid or refers to a template not in the directory
+func NewDirStore(dir string) (*DirStore, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("could not read template directory %s: %w", dir, err)
+	}
+	store := &DirStore{dir: dir, memory: NewMemoryStore(), names: make(map[string]string)}
+	var invalid []string
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if entry.IsDir() || !ok || entry.Name() == dirStoreNames {
+			continue
+		}
+		file, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("could not read template %s: %w", entry.Name(), err)
+		}
+		id, err := ValidateRegistryTemplate(string(file))
+		if err == nil {
+			err = validateTemplateName(name)
+		}
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %s", entry.Name(), err))
+			continue
+		}
+		if strings.EqualFold(name, id) {
+			name = ""
+		}
+		_ = store.memory.PutTemplate(context.Background(), name, id, string(file))
+	}
+
+	names, err := os.ReadFile(filepath.Join(dir, dirStoreNames))
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("could not read %s: %w", dirStoreNames, err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(names, &store.names); err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", dirStoreNames, err)
+		}
+	}
+	for name, id := range store.names {
+		if err := validateTemplateName(name); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: %s", dirStoreNames, err))
+			continue
+		}
+		if _, err := store.memory.TemplateByID(context.Background(), id); err != nil {
+			invalid = append(invalid, fmt.Sprintf("%s: template %s of %s is not in the directory", dirStoreNames, id, name))
+			continue
+		}
+		store.memory.names[name] = strings.ToLower(id)
+	}
+	if len(invalid) > 0 {
+		slices.Sort(invalid)
+		return nil, fmt.Errorf("invalid templates in %s:\n%s", dir, strings.Join(invalid, "\n"))
+	}
+	return store, nil
+}
+
+func (s *DirStore) TemplateByID(ctx context.Context, id string) (string, error) {
+	return s.memory.TemplateByID(ctx, id)
+}
+
+func (s *DirStore) TemplateByName(ctx context.Context, name string) (string, error) {
+	return s.memory.TemplateByName(ctx, name)
+}
+
+func (s *DirStore) PutTemplate(ctx context.Context, name string, id string, template string) error {
+	id = strings.ToLower(id)
+	if err := os.WriteFile(filepath.Join(s.dir, id+".json"), []byte(template), 0644); err != nil {
+		return fmt.Errorf("could not write template %s: %w", id, err)
+	}
+	if name == "" {
+		return s.memory.PutTemplate(ctx, name, id, template)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	names := maps.Clone(s.names)
+	names[name] = id
+	if err := s.writeNames(names); err != nil {
+		return err
+	}
+	s.names = names
+	return s.memory.PutTemplate(ctx, name, id, template)
+}
+
+// writeNames replaces names.json, the index is written to a temporary file first so a failed write keeps the old index
+func (s *DirStore) writeNames(names map[string]string) error {
+	data, err := json.MarshalIndent(names, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(s.dir, dirStoreNames+".tmp")
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return fmt.Errorf("could not write %s: %w", dirStoreNames, err)
+	}
+	if err := os.Rename(tmp, filepath.Join(s.dir, dirStoreNames)); err != nil {
+		return fmt.Errorf("could not write %s: %w", dirStoreNames, err)
+	}
+	return nil
+}
+
+// maxTemplateSize limits the body of template uploads
+const maxTemplateSize = 1 << 20
+
+/*
+http.Handler of a template registry serving the requests FlixService sends to FlixServerURL,
+set FlixServerURL to the address the handler is served at followed by /v1/templates
+*/
+type registryHandler struct {
+	store TemplateStore
+	mux   *http.ServeMux
+}
+
+// NewRegistryHandler returns a handler serving GET /v1/templates?name=, GET /v1/templates/{id}
+// and POST /v1/templates?name= uploads of 1.1.0 templates, uploads are validated with ValidateRegistryTemplate
+func NewRegistryHandler(store TemplateStore) http.Handler {
+	h := &registryHandler{store: store, mux: http.NewServeMux()}
+	h.mux.HandleFunc("GET /v1/templates", h.getByName)
+	h.mux.HandleFunc("GET /v1/templates/{id}", h.getByID)
+	h.mux.HandleFunc("POST /v1/templates", h.upload)
+	return h
+}
+
+func (h *registryHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	h.mux.ServeHTTP(w, r)
+}
+
+func (h *registryHandler) getByName(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if name == "" {
+		writeRegistryError(w, http.StatusBadRequest, "name is required")
+		return
+	}
+	template, err := h.store.TemplateByName(r.Context(), name)
+	h.writeTemplate(w, template, err)
+}
+
+func (h *registryHandler) getByID(w http.ResponseWriter, r *http.Request) {
+	template, err := h.store.TemplateByID(r.Context(), r.PathValue("id"))
+	h.writeTemplate(w, template, err)
+}
+
+func (h *registryHandler) writeTemplate(w http.ResponseWriter, template string, err error) {
+	switch {
+	case errors.Is(err, ErrTemplateNotFound):
+		writeRegistryError(w, http.StatusNotFound, err.Error())
+	case err != nil:
+		writeRegistryError(w, http.StatusInternalServerError, err.Error())
+	default:
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, template)
+	}
+}
+
+func (h *registryHandler) upload(w http.ResponseWriter, r *http.Request) {
+	name := r.URL.Query().Get("name")
+	if err := validateTemplateName(name); err != nil {
+		writeRegistryError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxTemplateSize))
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		writeRegistryError(w, http.StatusRequestEntityTooLarge, err.Error())
+		return
+	}
+	if err != nil {
+		writeRegistryError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	id, err := ValidateRegistryTemplate(string(body))
+	if err != nil {
+		writeRegistryError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	if err := h.store.PutTemplate(r.Context(), name, id, string(body)); err != nil {
+		writeRegistryError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+	_ = json.NewEncoder(w).Encode(map[string]string{"id": id})
+}
+
+func writeRegistryError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": message})
+}
@@ -0,0 +1,146 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func upload(t *testing.T, url string, name string, template string) (int, map[string]string) {
+	resp, err := http.Post(url+"/v1/templates?name="+name, "application/json", strings.NewReader(template))
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	var body map[string]string
+	assert.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return resp.StatusCode, body
+}
+
+func TestValidateRegistryTemplate(t *testing.T) {
+	assert := assert.New(t)
+	template := verifiedTemplate(t, minimumParamTemplateTS_TX)
+	id, err := ValidateRegistryTemplate(template)
+	assert.NoError(err)
+	assert.Len(id, 64)
+
+	_, err = ValidateRegistryTemplate(strings.Replace(template, id, strings.Repeat("0", 64), 1))
+	assert.ErrorContains(err, "does not match its content")
+
+	v1Template, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	_, err = ValidateRegistryTemplate(string(v1Template))
+	assert.Error(err)
+}
+
+func TestRegistryHandler(t *testing.T) {
+	assert := assert.New(t)
+	server := httptest.NewServer(NewRegistryHandler(NewMemoryStore()))
+	defer server.Close()
+
+	template := verifiedTemplate(t, minimumParamTemplateTS_TX)
+	status, body := upload(t, server.URL, "update-greeting", template)
+	assert.Equal(http.StatusCreated, status)
+	id := body["id"]
+
+	status, body = upload(t, server.URL, "update-greeting", strings.Replace(template, "log(acct.address)", "log(acct)", 1))
+	assert.Equal(http.StatusUnprocessableEntity, status)
+	assert.Contains(body["error"], "does not match its content")
+
+	status, body = upload(t, server.URL, "../update-greeting", template)
+	assert.Equal(http.StatusBadRequest, status)
+	assert.Contains(body["error"], "invalid template name")
+
+	resp, err := http.Post(server.URL+"/v1/templates", "application/json", strings.NewReader(strings.Repeat(" ", maxTemplateSize+1)))
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusRequestEntityTooLarge, resp.StatusCode)
+
+	recorder := httptest.NewRecorder()
+	NewRegistryHandler(NewMemoryStore()).ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/v1/templates", iotest.ErrReader(errors.New("connection reset"))))
+	assert.Equal(http.StatusBadRequest, recorder.Code)
+
+	resp, err = http.Get(server.URL + "/v1/templates/" + strings.Repeat("0", 64))
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusNotFound, resp.StatusCode)
+
+	resp, err = http.Get(server.URL + "/v1/templates")
+	assert.NoError(err)
+	resp.Body.Close()
+	assert.Equal(http.StatusBadRequest, resp.StatusCode)
+
+	flixService := NewFlixService(&FlixServiceConfig{FlixServerURL: server.URL + "/v1/templates"})
+	byName, _, err := flixService.GetTemplate(context.Background(), "update-greeting")
+	assert.NoError(err)
+	assert.Equal(template, byName)
+
+	byID, source, err := flixService.GetTemplate(context.Background(), id)
+	assert.NoError(err)
+	assert.Equal(template, byID)
+	assert.Equal(server.URL+"/v1/templates/"+id, source)
+
+	execution, err := flixService.GetTemplateAndReplaceImportsWithOptions(context.Background(), "update-greeting", "local", ResolveOptions{
+		ContractOverrides: map[string]string{"HelloWorld": "0x01cf0e2f2f715450"},
+	})
+	assert.NoError(err)
+	assert.Contains(execution.Cadence, "import HelloWorld from 0x01cf0e2f2f715450")
+}
+
+func TestDirStore(t *testing.T) {
+	assert := assert.New(t)
+	dir := t.TempDir()
+	template := verifiedTemplate(t, minimumParamTemplateTS_TX)
+	assert.NoError(os.WriteFile(filepath.Join(dir, "update-greeting.json"), []byte(template), 0644))
+	assert.NoError(os.WriteFile(filepath.Join(dir, "README.md"), []byte("templates"), 0644))
+
+	store, err := NewDirStore(dir)
+	assert.NoError(err)
+	stored, err := store.TemplateByName(context.Background(), "update-greeting")
+	assert.NoError(err)
+	assert.Equal(template, stored)
+
+	script := verifiedTemplate(t, minimumNoParamTemplateTS_SCRIPT)
+	server := httptest.NewServer(NewRegistryHandler(store))
+	defer server.Close()
+	status, body := upload(t, server.URL, "", script)
+	assert.Equal(http.StatusCreated, status)
+	assert.FileExists(filepath.Join(dir, body["id"]+".json"))
+
+	// named uploads are written by id too, the name is kept in names.json
+	updated := *minimumParamTemplateTS_TX
+	updated.Data.Cadence.Body = strings.Replace(updated.Data.Cadence.Body, "log(acct.address)", "log(acct)", 1)
+	named := verifiedTemplate(t, &updated)
+	status, namedBody := upload(t, server.URL, "update-greeting", named)
+	assert.Equal(http.StatusCreated, status)
+	assert.FileExists(filepath.Join(dir, namedBody["id"]+".json"))
+	assert.FileExists(filepath.Join(dir, "names.json"))
+
+	reloaded, err := NewDirStore(dir)
+	assert.NoError(err)
+	stored, err = reloaded.TemplateByID(context.Background(), body["id"])
+	assert.NoError(err)
+	assert.Equal(script, stored)
+	stored, err = reloaded.TemplateByName(context.Background(), "update-greeting")
+	assert.NoError(err)
+	assert.Equal(named, stored)
+	stored, err = reloaded.TemplateByID(context.Background(), namedBody["id"])
+	assert.NoError(err)
+	assert.Equal(named, stored)
+
+	assert.NoError(os.WriteFile(filepath.Join(dir, "names.json"), []byte(`{"missing":"`+strings.Repeat("0", 64)+`"}`), 0644))
+	_, err = NewDirStore(dir)
+	assert.ErrorContains(err, "is not in the directory")
+	assert.NoError(os.Remove(filepath.Join(dir, "names.json")))
+
+	assert.NoError(os.WriteFile(filepath.Join(dir, "broken.json"), []byte(`{"f_version":"1.1.0"}`), 0644))
+	_, err = NewDirStore(dir)
+	assert.ErrorContains(err, "broken.json")
+}
@@ -4,8 +4,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
 	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/onflow/flixkit-go/v2/internal/common"
@@ -17,10 +21,33 @@ type FileReader interface {
 	ReadFile(path string) ([]byte, error)
 }
 
+type FileWriter interface {
+	WriteFile(path string, data []byte, perm os.FileMode) error
+}
+
+/*
+FileReader that can also list directories, bundles include every template file of a listed directory
+*/
+type DirReader interface {
+	ReadDir(path string) ([]fs.DirEntry, error)
+}
+
+/*
+FileWriter that can also create directories, batches create the directory of every template they write
+*/
+type DirMaker interface {
+	MkdirAll(path string, perm os.FileMode) error
+}
+
 type FlixServiceConfig struct {
 	FlixServerURL string
 	FileReader    FileReader
+	FileWriter    FileWriter
 	Logger        common.Logger
+	// CoreContracts overrides addresses of the built-in core contract registry
+	CoreContracts ContractInfos
+	// CustomBindings are binding templates by language, they take precedence over the built-in languages
+	CustomBindings map[string]CustomBinding
 }
 
 func NewFlixService(config *FlixServiceConfig) flixService {
@@ -38,10 +65,33 @@ type flixService struct {
 }
 
 type FlowInteractionTemplateExecution struct {
+	ID            string
 	Network       string
 	Cadence       string
 	IsTransaciton bool
 	IsScript      bool
+	Title         string
+	Description   string
+	// Parameters are ordered by index
+	Parameters []ExecutionParameter
+	// Output is only set for v1.1 scripts that declare an output
+	Output *ExecutionParameter
+	// Contracts maps imported contract names to their address on the network
+	Contracts map[string]string
+	// NetworkPin is the template network pin of the network, empty when the template has none
+	NetworkPin string
+	// NetworkPinVerified is true when NetworkPin matches the hash of the replaced Cadence
+	NetworkPinVerified bool
+	// Overrides are the contract overrides of the resolve options that were used
+	Overrides []AppliedOverride
+}
+
+type ExecutionParameter struct {
+	Label       string
+	Index       int
+	Type        string
+	Title       string
+	Description string
 }
 
 /*
@@ -50,16 +100,22 @@ Deployed contracts to network addresses
 type NetworkAddressMap = v1_1.NetworkAddressMap
 type NetworkConfig = common.NetworkConfig
 
+/*
+Network aliases and contract address overrides used when replacing imports
+*/
+type ResolveOptions = common.ResolveOptions
+type AppliedOverride = common.AppliedOverride
+
 /*
 contract name associated with network information
 */
 type ContractInfos = v1_1.ContractInfos
 
-type flowInteractionTemplateCadence interface {
-	ReplaceCadenceImports(templateName string) (string, error)
-	IsTransaction() bool
-	IsScript() bool
-}
+/*
+pins that moved and the old and new id of a refreshed template
+*/
+type RefreshSummary = v1_1.RefreshSummary
+type DependencyPinChange = v1_1.DependencyPinChange
 
 func (s flixService) GetTemplate(ctx context.Context, flixQuery string) (string, string, error) {
 	var template string
@@ -113,42 +169,38 @@ func (s flixService) GetTemplate(ctx context.Context, flixQuery string) (string,
 }
 
 func (s flixService) GetTemplateAndReplaceImports(ctx context.Context, templateName string, network string) (*FlowInteractionTemplateExecution, error) {
+	return s.GetTemplateAndReplaceImportsWithOptions(ctx, templateName, network, ResolveOptions{})
+}
+
+func (s flixService) GetTemplateAndReplaceImportsWithOptions(ctx context.Context, templateName string, network string, opts ResolveOptions) (*FlowInteractionTemplateExecution, error) {
 	template, _, err := s.GetTemplate(ctx, templateName)
 	if err != nil {
 		return nil, err
 	}
-	var execution FlowInteractionTemplateExecution
-	var cadenceCode string
+	var execution *FlowInteractionTemplateExecution
 	ver, err := getTemplateVersion(template)
 	if err != nil {
 		return nil, fmt.Errorf("invalid flix template version, %w", err)
 	}
-	var replaceableCadence flowInteractionTemplateCadence
 	switch ver {
 	case "1.1.0":
-		replaceableCadence, err = v1_1.ParseFlix(template)
+		flix, err := v1_1.ParseFlix(template)
 		if err != nil {
 			return nil, err
 		}
-		cadenceCode, err = replaceableCadence.ReplaceCadenceImports(network)
+		execution, err = executionV1_1(flix, network, opts)
 		if err != nil {
 			return nil, err
 		}
-		execution.Cadence = cadenceCode
-		execution.IsScript = replaceableCadence.IsScript()
-		execution.IsTransaciton = replaceableCadence.IsTransaction()
 	case "1.0.0":
-		replaceableCadence, err = v1.ParseFlix(template)
+		flix, err := v1.ParseFlix(template)
 		if err != nil {
 			return nil, err
 		}
-		cadenceCode, err = replaceableCadence.ReplaceCadenceImports(network)
+		execution, err = executionV1_0(flix, network, opts)
 		if err != nil {
 			return nil, err
 		}
-		execution.Cadence = cadenceCode
-		execution.IsScript = replaceableCadence.IsScript()
-		execution.IsTransaciton = replaceableCadence.IsTransaction()
 	default:
 		return nil, fmt.Errorf("flix template version: %s not supported", ver)
 	}
@@ -157,24 +209,109 @@ func (s flixService) GetTemplateAndReplaceImports(ctx context.Context, templateN
 		return nil, fmt.Errorf("could not parse template, invalid flix template")
 	}
 
-	return &execution, nil
+	return execution, nil
+}
+
+func executionV1_1(flix *v1_1.InteractionTemplate, network string, opts ResolveOptions) (*FlowInteractionTemplateExecution, error) {
+	resolvedNetwork := opts.ResolveNetwork(network)
+	cadenceCode, overrides, err := flix.ReplaceCadenceImportsWithOptions(network, opts)
+	if err != nil {
+		return nil, err
+	}
+	var msgs v1_1.InteractionTemplateMessages = flix.Data.Messages
+	execution := &FlowInteractionTemplateExecution{
+		ID:            flix.ID,
+		Network:       network,
+		Cadence:       cadenceCode,
+		IsTransaciton: flix.IsTransaction(),
+		IsScript:      flix.IsScript(),
+		Title:         msgs.GetTitle(""),
+		Description:   msgs.GetDescription(""),
+		Parameters:    make([]ExecutionParameter, 0),
+		Contracts:     applyOverrides(flix.ResolveContracts(resolvedNetwork), overrides),
+		Overrides:     overrides,
+	}
+	for _, param := range flix.Data.Parameters {
+		execution.Parameters = append(execution.Parameters, executionParameterV1_1(param))
+	}
+	sort.SliceStable(execution.Parameters, func(i, j int) bool {
+		return execution.Parameters[i].Index < execution.Parameters[j].Index
+	})
+	if flix.IsScript() && flix.Data.Output != nil {
+		output := executionParameterV1_1(*flix.Data.Output)
+		execution.Output = &output
+	}
+	for _, pin := range flix.Data.Cadence.NetworkPins {
+		if pin.Network == resolvedNetwork {
+			execution.NetworkPin = pin.PinSelf
+			execution.NetworkPinVerified = pin.PinSelf == v1_1.ShaHex(cadenceCode, "")
+		}
+	}
+	return execution, nil
+}
+
+func executionParameterV1_1(param v1_1.Parameter) ExecutionParameter {
+	var msgs v1_1.InteractionTemplateMessages = param.Messages
+	return ExecutionParameter{
+		Label:       param.Label,
+		Index:       param.Index,
+		Type:        param.Type,
+		Title:       msgs.GetTitle(""),
+		Description: msgs.GetDescription(""),
+	}
+}
+
+func executionV1_0(flix *v1.FlowInteractionTemplate, network string, opts ResolveOptions) (*FlowInteractionTemplateExecution, error) {
+	cadenceCode, overrides, err := flix.ReplaceCadenceImportsWithOptions(network, opts)
+	if err != nil {
+		return nil, err
+	}
+	execution := &FlowInteractionTemplateExecution{
+		ID:            flix.ID,
+		Network:       network,
+		Cadence:       cadenceCode,
+		IsTransaciton: flix.IsTransaction(),
+		IsScript:      flix.IsScript(),
+		Title:         flix.Data.Messages.GetTitleValue(""),
+		Description:   flix.GetDescription(),
+		Parameters:    make([]ExecutionParameter, 0),
+		Contracts:     applyOverrides(flix.ResolveContracts(opts.ResolveNetwork(network)), overrides),
+		Overrides:     overrides,
+	}
+	for label, arg := range flix.Data.Arguments {
+		execution.Parameters = append(execution.Parameters, ExecutionParameter{
+			Label:       label,
+			Index:       arg.Index,
+			Type:        arg.Type,
+			Title:       arg.Messages.GetTitleValue(""),
+			Description: arg.Messages.GetDescriptionValue(""),
+		})
+	}
+	sort.SliceStable(execution.Parameters, func(i, j int) bool {
+		return execution.Parameters[i].Index < execution.Parameters[j].Index
+	})
+	return execution, nil
+}
+
+func applyOverrides(contracts map[string]string, overrides []AppliedOverride) map[string]string {
+	for _, override := range overrides {
+		contracts[override.Contract] = override.Address
+	}
+	return contracts
 }
 
 func (s flixService) GetTemplateAndCreateBinding(ctx context.Context, templateName string, lang string, destFileLocation string) (string, error) {
+	return s.GetTemplateAndCreateBindingWithOptions(ctx, templateName, lang, destFileLocation, BindingOptions{})
+}
+
+func (s flixService) GetTemplateAndCreateBindingWithOptions(ctx context.Context, templateName string, lang string, destFileLocation string, opts BindingOptions) (string, error) {
 	template, source, err := s.GetTemplate(ctx, templateName)
 	if err != nil {
 		return "", err
 	}
-	language := strings.ToLower(lang)
-	var gen *FclCreator
-
-	switch language {
-	case "js", "javascript":
-		gen = NewFclJSCreator()
-	case "ts", "typescript":
-		gen = NewFclTSCreator()
-	default:
-		return "", fmt.Errorf("language %s not supported", lang)
+	gen, err := s.bindingCreator(lang, destFileLocation)
+	if err != nil {
+		return "", err
 	}
 
 	relativeTemplateLocation := source
@@ -186,20 +323,121 @@ func (s flixService) GetTemplateAndCreateBinding(ctx context.Context, templateNa
 		}
 	}
 
-	return gen.Create(template, relativeTemplateLocation)
+	return gen.CreateWithOptions(template, relativeTemplateLocation, opts)
+}
+
+func (s flixService) GetTemplatesAndCreateBundle(ctx context.Context, templateNames []string, lang string, destDir string, mode BundleMode) ([]BindingFile, error) {
+	gen, err := s.bindingCreator(lang, filepath.Join(destDir, "index"))
+	if err != nil {
+		return nil, err
+	}
+
+	var bundle []BundleTemplate
+	for _, name := range s.expandTemplateDirs(templateNames) {
+		template, source, err := s.GetTemplate(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		location := source
+		if getType(source, s.config.FileReader) == flixPath {
+			// modules are written to the bundle directory
+			location, err = getRelativePath(name, filepath.Join(destDir, "index"))
+			if err != nil {
+				return nil, err
+			}
+		}
+		bundle = append(bundle, BundleTemplate{Template: template, Location: location})
+	}
+
+	return gen.CreateBundle(bundle, mode)
+}
+
+// expandTemplateDirs replaces directories with the json files in them when the file reader can list directories
+func (s flixService) expandTemplateDirs(templateNames []string) []string {
+	dirs, ok := s.config.FileReader.(DirReader)
+	if !ok {
+		return templateNames
+	}
+	var expanded []string
+	for _, name := range templateNames {
+		entries, err := dirs.ReadDir(name)
+		if err != nil {
+			expanded = append(expanded, name)
+			continue
+		}
+		// entries are sorted by file name
+		for _, entry := range entries {
+			if !entry.IsDir() && filepath.Ext(entry.Name()) == ".json" {
+				expanded = append(expanded, filepath.Join(name, entry.Name()))
+			}
+		}
+	}
+	return expanded
+}
+
+// bindingCreator returns the custom binding of the language, or the built-in one when there is none
+func (s flixService) bindingCreator(lang string, destFile string) (*FclCreator, error) {
+	if custom, ok := s.config.CustomBindings[lang]; ok {
+		return custom.creator(destFile)
+	}
+	return newBindingCreator(lang, destFile)
+}
+
+func newBindingCreator(lang string, destFile string) (*FclCreator, error) {
+	switch strings.ToLower(lang) {
+	case "js", "javascript":
+		return NewFclJSCreator(), nil
+	case "ts", "typescript":
+		return NewFclTSCreator(), nil
+	case "react":
+		return NewReactCreator(), nil
+	case "py", "python":
+		return NewPythonCreator(), nil
+	case "go", "golang":
+		return NewGoCreator(bindingPackageName(destFile)), nil
+	case "swift":
+		return NewSwiftCreator(), nil
+	case "kotlin", "kt":
+		return NewKotlinCreator(bindingPackageName(destFile)), nil
+	default:
+		return nil, fmt.Errorf("language %s not supported", lang)
+	}
 }
 
 func (s flixService) CreateTemplate(ctx context.Context, deployedContracts ContractInfos, code string, preFill string, networks []common.NetworkConfig) (string, error) {
 	template, _, _ := s.GetTemplate(ctx, preFill)
 	var gen *v1_1.Generator
 	var err2 error
-	gen, err2 = v1_1.NewTemplateGenerator(deployedContracts, s.config.Logger, networks)
+	gen, err2 = s.newTemplateGenerator(deployedContracts, networks)
 	if err2 != nil {
 		return "", err2
 	}
 	return gen.CreateTemplate(ctx, code, template)
 }
 
+func (s flixService) newTemplateGenerator(deployedContracts ContractInfos, networks []common.NetworkConfig) (*v1_1.Generator, error) {
+	gen, err := v1_1.NewTemplateGenerator(deployedContracts, s.config.Logger, networks)
+	if err != nil {
+		return nil, err
+	}
+	if s.config.CoreContracts != nil {
+		gen.OverrideCoreContracts(s.config.CoreContracts)
+	}
+	return gen, nil
+}
+
+func (s flixService) RefreshTemplate(ctx context.Context, templateName string, networks []common.NetworkConfig) (string, *RefreshSummary, error) {
+	template, _, err := s.GetTemplate(ctx, templateName)
+	if err != nil {
+		return "", nil, err
+	}
+	gen, err := s.newTemplateGenerator(nil, networks)
+	if err != nil {
+		return "", nil, err
+	}
+	return gen.RefreshTemplate(ctx, template)
+}
+
 func (s flixService) getFlixRaw(ctx context.Context, templateName string) (string, string, error) {
 	url := fmt.Sprintf("%s?name=%s", s.config.FlixServerURL, templateName)
 	return fetchFlixWithContext(ctx, url)
@@ -250,3 +488,7 @@ func fetchFlixWithContext(ctx context.Context, url string) (template string, tem
 	template = string(body)
 	return template, url, err
 }
+
+func CoreContracts() ContractInfos {
+	return v1_1.CoreContracts()
+}
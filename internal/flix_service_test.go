@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -332,6 +333,86 @@ func TestGetAndReplaceCadenceSimpleImportsV11(t *testing.T) {
 	}
 }
 
+func TestReplaceCadenceImportsWithOptions(t *testing.T) {
+	assert := assert.New(t)
+
+	v1Template := &v1.FlowInteractionTemplate{
+		Data: v1.Data{
+			Cadence: "import FungibleToken from 0xFUNGIBLETOKENADDRESS\nimport FlowToken from 0xFLOWTOKENADDRESS",
+			Dependencies: v1.Dependencies{
+				"0xFUNGIBLETOKENADDRESS": v1.Contracts{
+					"FungibleToken": v1.Networks{
+						"emulator": v1.Network{Address: "0xee82856bf20e2aa6"},
+					},
+				},
+				"0xFLOWTOKENADDRESS": v1.Contracts{
+					"FlowToken": v1.Networks{},
+				},
+			},
+		},
+	}
+	v11Template := &v1_1.InteractionTemplate{
+		Data: v1_1.Data{
+			Cadence: v1_1.Cadence{
+				Body: "import \"FungibleToken\"\nimport \"FlowToken\"",
+			},
+			Dependencies: []v1_1.Dependency{
+				{
+					Contracts: []v1_1.Contract{
+						{
+							Contract: "FungibleToken",
+							Networks: []v1_1.Network{
+								{Network: "emulator", Address: "0xee82856bf20e2aa6"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	opts := ResolveOptions{
+		NetworkAliases:    map[string]string{"local": "emulator"},
+		ContractOverrides: map[string]string{"FlowToken": "0ae53cb6e3f42a79"},
+	}
+	want := "import FungibleToken from 0xee82856bf20e2aa6\nimport FlowToken from 0x0ae53cb6e3f42a79"
+	wantOverrides := []AppliedOverride{
+		{Contract: "FlowToken", Address: "0x0ae53cb6e3f42a79"},
+	}
+
+	cadence, overrides, err := v1Template.ReplaceCadenceImportsWithOptions("local", opts)
+	assert.NoError(err)
+	assert.Equal(want, cadence)
+	assert.Equal(wantOverrides, overrides)
+
+	cadence, overrides, err = v11Template.ReplaceCadenceImportsWithOptions("local", opts)
+	assert.NoError(err)
+	assert.Equal(want, cadence)
+	assert.Equal(wantOverrides, overrides)
+
+	// overrides take precedence over template addresses
+	opts.ContractOverrides["FungibleToken"] = "0xf8d6e0586b0a20c7"
+	cadence, overrides, err = v11Template.ReplaceCadenceImportsWithOptions("local", opts)
+	assert.NoError(err)
+	assert.Contains(cadence, "import FungibleToken from 0xf8d6e0586b0a20c7")
+	assert.Contains(overrides, AppliedOverride{Contract: "FungibleToken", Address: "0xf8d6e0586b0a20c7", TemplateAddress: "0xee82856bf20e2aa6"})
+
+	// template addresses are reported normalized for both versions
+	v1Template.Data.Dependencies["0xFUNGIBLETOKENADDRESS"]["FungibleToken"]["emulator"] = v1.Network{Address: "0x1"}
+	v11Template.Data.Dependencies[0].Contracts[0].Networks[0].Address = "0x1"
+	_, v1Overrides, err := v1Template.ReplaceCadenceImportsWithOptions("local", opts)
+	assert.NoError(err)
+	_, overrides, err = v11Template.ReplaceCadenceImportsWithOptions("local", opts)
+	assert.NoError(err)
+	assert.Contains(overrides, AppliedOverride{Contract: "FungibleToken", Address: "0xf8d6e0586b0a20c7", TemplateAddress: "0x0000000000000001"})
+	assert.ElementsMatch(overrides, v1Overrides)
+
+	// without an alias or override the network is still required
+	_, err = v1Template.ReplaceCadenceImports("local")
+	assert.Error(err)
+	_, _, err = v11Template.ReplaceCadenceImportsWithOptions("emulator", ResolveOptions{})
+	assert.Error(err)
+}
+
 func TestIsScript(t *testing.T) {
 	assert := assert.New(t)
 
@@ -560,3 +641,49 @@ func TestParseFlixV1(t *testing.T) {
 	assert.NoError(err, "ReplaceCadenceImports should not return an error")
 	assert.Equal("access(all) fun main(x: Int, y: Int): Int { return x * y }", v, "ReplaceCadenceImports should return the correct cadence")
 }
+
+func TestGetTemplateAndReplaceImportsExecution(t *testing.T) {
+	assert := assert.New(t)
+	flixService := NewFlixService(&FlixServiceConfig{})
+	ctx := context.Background()
+
+	execution, err := flixService.GetTemplateAndReplaceImports(ctx, ReadTokenScript, "mainnet")
+	assert.NoError(err, "GetTemplateAndReplaceImports should not return an error")
+	assert.Equal("29d03aafbbb5a02e0d5f4ffee685c12494915410812305c2858008d3e2902b72", execution.ID)
+	assert.Equal("mainnet", execution.Network)
+	assert.True(execution.IsScript)
+	assert.Equal(map[string]string{
+		"FungibleToken": "0xf233dcee88fe0abe",
+		"FlowToken":     "0x1654653399040a61",
+	}, execution.Contracts)
+	assert.Equal([]ExecutionParameter{{Label: "address", Index: 0, Type: "Address"}}, execution.Parameters)
+	assert.Equal("e0a1c0443b724d1238410c4a05c48441ee974160cad8cf1103c63b6999f81dd5", execution.NetworkPin)
+	assert.False(execution.NetworkPinVerified, "fixture network pin does not match its cadence")
+
+	flix, err := v1_1.ParseFlix(ReadTokenScript)
+	assert.NoError(err, "ParseFlix should not return an error")
+	flix.Data.Cadence.NetworkPins[0].PinSelf = v1_1.ShaHex(execution.Cadence, "")
+	pinned, err := json.Marshal(flix)
+	assert.NoError(err, "marshal template to json should not return an error")
+	execution, err = flixService.GetTemplateAndReplaceImports(ctx, string(pinned), "mainnet")
+	assert.NoError(err, "GetTemplateAndReplaceImports should not return an error")
+	assert.True(execution.NetworkPinVerified, "network pin should match the replaced cadence")
+
+	execution, err = flixService.GetTemplateAndReplaceImports(ctx, ReadTokenScript, "emulator")
+	assert.NoError(err, "GetTemplateAndReplaceImports should not return an error")
+	assert.Empty(execution.NetworkPin, "template has no emulator network pin")
+	assert.False(execution.NetworkPinVerified)
+
+	execution, err = flixService.GetTemplateAndReplaceImports(ctx, flix_template, "testnet")
+	assert.NoError(err, "GetTemplateAndReplaceImports should not return an error")
+	assert.Equal("testnet", execution.Network)
+	assert.True(execution.IsTransaciton)
+	assert.Equal("Transfer Tokens", execution.Title)
+	assert.Equal("Transfer tokens from one account to another", execution.Description)
+	assert.Equal(map[string]string{"FungibleToken": "0x9a0766d93b6608b7"}, execution.Contracts)
+	assert.Equal([]ExecutionParameter{
+		{Label: "amount", Index: 0, Type: "UFix64", Title: "The amount of FLOW tokens to send"},
+		{Label: "to", Index: 1, Type: "Address", Title: "The Flow account the tokens will go to"},
+	}, execution.Parameters)
+	assert.Nil(execution.Output)
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"fmt"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+/*
+Result of verifying a template, ComputedID and the computed network pins are calculated from the template content
+*/
+type TemplateVerification struct {
+	ID          string
+	ComputedID  string
+	NetworkPins []NetworkPinVerification
+}
+
+/*
+Network pin of a template, ComputedPin is empty when the imports cannot be replaced for the network
+*/
+type NetworkPinVerification struct {
+	Network     string
+	Pin         string
+	ComputedPin string
+}
+
+// Verified is true when the computed pin matches the pin of the template
+func (p NetworkPinVerification) Verified() bool {
+	return p.ComputedPin != "" && p.Pin == p.ComputedPin
+}
+
+// Verified is true when the id and every network pin match the template content
+func (v TemplateVerification) Verified() bool {
+	for _, pin := range v.NetworkPins {
+		if !pin.Verified() {
+			return false
+		}
+	}
+	return v.ID == v.ComputedID
+}
+
+// VerifyTemplate computes the id and network pins of a template, only ids of 1.1.0 templates can be computed
+func VerifyTemplate(template string) (*TemplateVerification, error) {
+	ver, err := getTemplateVersion(template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flix template version, %w", err)
+	}
+	if ver != "1.1.0" {
+		return nil, fmt.Errorf("template version %s cannot be verified, only ids of 1.1.0 templates can be verified", ver)
+	}
+	flix, err := v1_1.ParseFlix(template)
+	if err != nil {
+		return nil, err
+	}
+	id, err := v1_1.GenerateFlixID(flix)
+	if err != nil {
+		return nil, fmt.Errorf("could not compute template id: %w", err)
+	}
+	verification := &TemplateVerification{ID: flix.ID, ComputedID: id}
+	for _, pin := range flix.Data.Cadence.NetworkPins {
+		p := NetworkPinVerification{Network: pin.Network, Pin: pin.PinSelf}
+		if cadence, err := flix.ReplaceCadenceImports(pin.Network); err == nil {
+			p.ComputedPin = v1_1.ShaHex(cadence, "")
+		}
+		verification.NetworkPins = append(verification.NetworkPins, p)
+	}
+	return verification, nil
+}
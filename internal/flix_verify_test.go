@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+func TestVerifyTemplate(t *testing.T) {
+	assert := assert.New(t)
+	pinned := *minimumNoParamTemplateTS_SCRIPT
+	pinned.Data.Cadence = v1_1.Cadence{
+		Body: pinned.Data.Cadence.Body,
+		NetworkPins: []v1_1.NetworkPin{
+			{Network: "mainnet", PinSelf: v1_1.ShaHex(pinned.Data.Cadence.Body, "")},
+			{Network: "testnet", PinSelf: "0000"},
+		},
+	}
+	verification, err := VerifyTemplate(verifiedTemplate(t, &pinned))
+	assert.NoError(err)
+	assert.Equal(verification.ComputedID, verification.ID)
+	assert.Len(verification.NetworkPins, 2)
+	assert.True(verification.NetworkPins[0].Verified())
+	assert.False(verification.NetworkPins[1].Verified())
+	assert.False(verification.Verified(), "the testnet pin does not match")
+
+	pinned.Data.Cadence.NetworkPins = pinned.Data.Cadence.NetworkPins[:1]
+	verification, err = VerifyTemplate(verifiedTemplate(t, &pinned))
+	assert.NoError(err)
+	assert.True(verification.Verified())
+
+	ttemp, err := json.Marshal(&pinned)
+	assert.NoError(err, "marshal template to json should not return an error")
+	verification, err = VerifyTemplate(string(ttemp))
+	assert.NoError(err)
+	assert.NotEqual(verification.ComputedID, verification.ID)
+	assert.False(verification.Verified())
+
+	v1Template, err := json.Marshal(parsedTemplateTX)
+	assert.NoError(err, "marshal template to json should not return an error")
+	_, err = VerifyTemplate(string(v1Template))
+	assert.ErrorContains(err, "only ids of 1.1.0 templates can be verified")
+}
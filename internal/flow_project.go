@@ -0,0 +1,226 @@
+package internal
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/onflow/cadence/parser"
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flixkit-go/v2/internal/common"
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+/*
+Contract addresses and networks of a Flow project read from flow.json
+*/
+type FlowProject struct {
+	ContractInfos ContractInfos
+	Networks      []NetworkConfig
+}
+
+type flowJSON struct {
+	Contracts    map[string]json.RawMessage            `json:"contracts"`
+	Dependencies map[string]json.RawMessage            `json:"dependencies"`
+	Networks     map[string]json.RawMessage            `json:"networks"`
+	Accounts     map[string]json.RawMessage            `json:"accounts"`
+	Deployments  map[string]map[string]json.RawMessage `json:"deployments"`
+}
+
+type flowJSONContract struct {
+	Source  string            `json:"source"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+type flowJSONNetwork struct {
+	Host string `json:"host"`
+	Key  string `json:"key"`
+}
+
+type flowJSONAccount struct {
+	Address string `json:"address"`
+}
+
+type flowJSONDeployment struct {
+	Name string `json:"name"`
+}
+
+// LoadFlowProject reads a flow.json with the file reader and collects contract addresses per network
+// from contract aliases, dependencies and deployments
+func LoadFlowProject(reader FileReader, path string) (*FlowProject, error) {
+	if reader == nil {
+		return nil, fmt.Errorf("file reader not provided")
+	}
+	file, err := reader.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read flow project %s: %w", path, err)
+	}
+	project, err := ParseFlowProject(file)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse flow project %s: %w", path, err)
+	}
+	return project, nil
+}
+
+func ParseFlowProject(data []byte) (*FlowProject, error) {
+	var config flowJSON
+	err := json.Unmarshal(data, &config)
+	if err != nil {
+		return nil, err
+	}
+
+	project := &FlowProject{
+		ContractInfos: make(ContractInfos),
+	}
+	addAddress := func(contract string, network string, address string) {
+		if project.ContractInfos[contract] == nil {
+			project.ContractInfos[contract] = make(NetworkAddressMap)
+		}
+		project.ContractInfos[contract][network] = flow.HexToAddress(address).HexWithPrefix()
+	}
+
+	for name, raw := range config.Contracts {
+		var contract flowJSONContract
+		// simple format is only the source path, which has no aliases
+		if json.Unmarshal(raw, &contract.Source) != nil {
+			err = json.Unmarshal(raw, &contract)
+			if err != nil {
+				return nil, fmt.Errorf("invalid contract %s: %w", name, err)
+			}
+		}
+		for network, address := range contract.Aliases {
+			addAddress(name, network, address)
+		}
+	}
+
+	for name, raw := range config.Dependencies {
+		var dependency flowJSONContract
+		if json.Unmarshal(raw, &dependency.Source) != nil {
+			err = json.Unmarshal(raw, &dependency)
+			if err != nil {
+				return nil, fmt.Errorf("invalid dependency %s: %w", name, err)
+			}
+		}
+		// source is formatted as network://address.ContractName
+		if network, location, ok := strings.Cut(dependency.Source, "://"); ok {
+			if address, _, ok := strings.Cut(location, "."); ok {
+				addAddress(name, network, address)
+			}
+		}
+		for network, address := range dependency.Aliases {
+			addAddress(name, network, address)
+		}
+	}
+
+	accounts := make(map[string]string)
+	for name, raw := range config.Accounts {
+		var account flowJSONAccount
+		err = json.Unmarshal(raw, &account)
+		if err != nil {
+			return nil, fmt.Errorf("invalid account %s: %w", name, err)
+		}
+		accounts[name] = account.Address
+	}
+
+	for network, deployments := range config.Deployments {
+		for accountName, raw := range deployments {
+			address, ok := accounts[accountName]
+			if !ok {
+				return nil, fmt.Errorf("deployment account %s on %s not found in accounts", accountName, network)
+			}
+			var contracts []json.RawMessage
+			err = json.Unmarshal(raw, &contracts)
+			if err != nil {
+				return nil, fmt.Errorf("invalid deployment for %s on %s: %w", accountName, network, err)
+			}
+			for _, c := range contracts {
+				var deployment flowJSONDeployment
+				// contracts are deployed by name or with init arguments as an object
+				if json.Unmarshal(c, &deployment.Name) != nil {
+					err = json.Unmarshal(c, &deployment)
+					if err != nil {
+						return nil, fmt.Errorf("invalid deployment for %s on %s: %w", accountName, network, err)
+					}
+				}
+				addAddress(deployment.Name, network, address)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(config.Networks)) {
+		raw := config.Networks[name]
+		var network flowJSONNetwork
+		if json.Unmarshal(raw, &network.Host) != nil {
+			err = json.Unmarshal(raw, &network)
+			if err != nil {
+				return nil, fmt.Errorf("invalid network %s: %w", name, err)
+			}
+		}
+		project.Networks = append(project.Networks, common.NetworkConfig{
+			Name: name,
+			Host: network.Host,
+			Key:  network.Key,
+		})
+	}
+
+	return project, nil
+}
+
+// NetworkConfigs returns the configuration of the named networks, all project networks when none are named
+func (p *FlowProject) NetworkConfigs(names ...string) ([]NetworkConfig, error) {
+	if len(names) == 0 {
+		return p.Networks, nil
+	}
+	var networks []NetworkConfig
+	for _, name := range names {
+		found := false
+		for _, network := range p.Networks {
+			if network.Name == name {
+				networks = append(networks, network)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("network %s not found in flow project", name)
+		}
+	}
+	return networks, nil
+}
+
+// CheckImports verifies every contract imported by the code has an address on each of the networks,
+// in the flow project or the built-in core contract registry. The returned error lists all missing imports at once
+func (p *FlowProject) CheckImports(code string, networks []string) error {
+	return p.CheckImportsWithCoreContracts(code, networks, nil)
+}
+
+// CheckImportsWithCoreContracts is CheckImports with addresses applied on top of the built-in core contract registry,
+// pass FlixServiceConfig.CoreContracts to check the addresses CreateTemplate resolves imports with
+func (p *FlowProject) CheckImportsWithCoreContracts(code string, networks []string, coreContracts ContractInfos) error {
+	program, err := parser.ParseProgram(nil, []byte(code), parser.Config{})
+	if err != nil {
+		return err
+	}
+	core := v1_1.MergeContractInfos(v1_1.CoreContracts(), coreContracts)
+	var errs []error
+	for _, imp := range program.ImportDeclarations() {
+		cadenceImport := v1_1.ClassifyImportDeclaration(imp)
+		if cadenceImport.Kind == v1_1.BuiltInImport || cadenceImport.Kind == v1_1.IdentifierImport {
+			continue
+		}
+		for _, name := range cadenceImport.Contracts() {
+			for _, network := range networks {
+				_, deployed := p.ContractInfos[name][network]
+				_, isCore := core[name][network]
+				if !deployed && !isCore {
+					errs = append(errs, fmt.Errorf("import %s has no alias or deployment on network %s", name, network))
+				}
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,123 @@
+package internal
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const flowProjectJSON = `{
+	"contracts": {
+		"HelloWorld": {
+			"source": "./cadence/contracts/HelloWorld.cdc",
+			"aliases": {
+				"testnet": "0x0000000000000001"
+			}
+		},
+		"Counter": "./cadence/contracts/Counter.cdc"
+	},
+	"dependencies": {
+		"FlowToken": {
+			"source": "mainnet://1654653399040a61.FlowToken",
+			"hash": "cefb25fd19d9fc80ce02896267eb6157a6b0df7b1935caa8641421fe34c0e67a",
+			"aliases": {
+				"emulator": "0ae53cb6e3f42a79",
+				"testnet": "7e60df042a9c0868"
+			}
+		},
+		"FungibleToken": "mainnet://f233dcee88fe0abe.FungibleToken"
+	},
+	"networks": {
+		"emulator": "127.0.0.1:3569",
+		"testnet": {
+			"host": "access.devnet.nodes.onflow.org:9000",
+			"key": "ba69f7d2e82b9edf25b103c195cd371cf0cc047ef8884a9bbe331e62982d46daeebf836f7445a2ac16741013b192959d8ad26998aff12f2adc67a99e1eb2988d"
+		}
+	},
+	"accounts": {
+		"emulator-account": {
+			"address": "f8d6e0586b0a20c7",
+			"key": "0000000000000000000000000000000000000000000000000000000000000001"
+		}
+	},
+	"deployments": {
+		"emulator": {
+			"emulator-account": [
+				"HelloWorld",
+				{
+					"name": "Counter",
+					"args": [{"type": "Int", "value": "1"}]
+				}
+			]
+		}
+	}
+}`
+
+func TestParseFlowProject(t *testing.T) {
+	assert := assert.New(t)
+
+	project, err := ParseFlowProject([]byte(flowProjectJSON))
+	assert.NoError(err, "ParseFlowProject should not return an error")
+	assert.Equal(ContractInfos{
+		"HelloWorld": {
+			"testnet":  "0x0000000000000001",
+			"emulator": "0xf8d6e0586b0a20c7",
+		},
+		"Counter": {
+			"emulator": "0xf8d6e0586b0a20c7",
+		},
+		"FlowToken": {
+			"mainnet":  "0x1654653399040a61",
+			"testnet":  "0x7e60df042a9c0868",
+			"emulator": "0x0ae53cb6e3f42a79",
+		},
+		"FungibleToken": {
+			"mainnet": "0xf233dcee88fe0abe",
+		},
+	}, project.ContractInfos)
+
+	assert.Len(project.Networks, 2)
+	assert.Equal("emulator", project.Networks[0].Name)
+	assert.Equal("127.0.0.1:3569", project.Networks[0].Host)
+	assert.Equal("access.devnet.nodes.onflow.org:9000", project.Networks[1].Host)
+
+	networks, err := project.NetworkConfigs("testnet")
+	assert.NoError(err, "NetworkConfigs should not return an error")
+	assert.Len(networks, 1)
+	_, err = project.NetworkConfigs("mainnet")
+	assert.Error(err, "NetworkConfigs should return an error for unknown networks")
+}
+
+func TestFlowProjectCheckImports(t *testing.T) {
+	assert := assert.New(t)
+
+	project, err := ParseFlowProject([]byte(flowProjectJSON))
+	assert.NoError(err, "ParseFlowProject should not return an error")
+
+	code := `
+	import Crypto
+	import "FlowToken"
+	import FungibleToken from 0xf233dcee88fe0abe
+	import "HelloWorld"
+
+	access(all) fun main(): Void {}
+`
+	assert.NoError(project.CheckImports(code, nil))
+	err = project.CheckImports(code, []string{"emulator", "mainnet"})
+	assert.EqualError(err, "import HelloWorld has no alias or deployment on network mainnet",
+		"core contracts missing from the flow project resolve with the core contract registry")
+	assert.NoError(project.CheckImportsWithCoreContracts(code, []string{"emulator", "mainnet"}, ContractInfos{
+		"HelloWorld": {"mainnet": "0xe15193734357cf5c"},
+	}))
+}
+
+func TestLoadFlowProject(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := LoadFlowProject(nil, "flow.json")
+	assert.Error(err, "LoadFlowProject should require a file reader")
+
+	project, err := LoadFlowProject(memoryFiles{"flow.json": []byte(flowProjectJSON)}, "flow.json")
+	assert.NoError(err, "LoadFlowProject should not return an error")
+	assert.Contains(project.ContractInfos, "FlowToken")
+}
@@ -0,0 +1,466 @@
+package internal
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	v1 "github.com/onflow/flixkit-go/v2/internal/v1"
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "changed"
+)
+
+type ParameterChange struct {
+	Kind     ChangeKind
+	Label    string
+	OldType  string
+	NewType  string
+	OldIndex int
+	NewIndex int
+}
+
+/*
+Translation change of a template message, Parameter is empty for template level messages
+*/
+type MessageChange struct {
+	Kind      ChangeKind
+	Parameter string
+	Key       string
+	Tag       string
+	Old       string
+	New       string
+}
+
+type DependencyChange struct {
+	Kind           ChangeKind
+	Contract       string
+	Network        string
+	OldAddress     string
+	NewAddress     string
+	OldPin         string
+	NewPin         string
+	OldBlockHeight uint64
+	NewBlockHeight uint64
+}
+
+type NetworkPinChange struct {
+	Kind    ChangeKind
+	Network string
+	Old     string
+	New     string
+}
+
+/*
+Semantic differences between two templates, CadenceDiff holds a unified diff of the cadence body
+*/
+type TemplateDiff struct {
+	OldID        string
+	NewID        string
+	OldVersion   string
+	NewVersion   string
+	CadenceDiff  string
+	Parameters   []ParameterChange
+	Messages     []MessageChange
+	Dependencies []DependencyChange
+	NetworkPins  []NetworkPinChange
+}
+
+func (d TemplateDiff) IDChanged() bool {
+	return d.OldID != d.NewID
+}
+
+func (d TemplateDiff) CadenceChanged() bool {
+	return d.CadenceDiff != ""
+}
+
+func (d TemplateDiff) HasChanges() bool {
+	return d.IDChanged() ||
+		d.OldVersion != d.NewVersion ||
+		d.CadenceChanged() ||
+		len(d.Parameters) > 0 ||
+		len(d.Messages) > 0 ||
+		len(d.Dependencies) > 0 ||
+		len(d.NetworkPins) > 0
+}
+
+// String renders the diff for humans, one change per line grouped by section
+func (d TemplateDiff) String() string {
+	if !d.HasChanges() {
+		return "no changes\n"
+	}
+	var b strings.Builder
+	if d.OldVersion != d.NewVersion {
+		fmt.Fprintf(&b, "version: %s -> %s\n", d.OldVersion, d.NewVersion)
+	}
+	if d.IDChanged() {
+		fmt.Fprintf(&b, "id: %s -> %s\n", d.OldID, d.NewID)
+	}
+	if d.CadenceChanged() {
+		b.WriteString("cadence:\n")
+		b.WriteString(d.CadenceDiff)
+	}
+	if len(d.Parameters) > 0 {
+		b.WriteString("parameters:\n")
+		for _, p := range d.Parameters {
+			switch p.Kind {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "  + %s: %s (index %d)\n", p.Label, p.NewType, p.NewIndex)
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "  - %s: %s (index %d)\n", p.Label, p.OldType, p.OldIndex)
+			default:
+				fmt.Fprintf(&b, "  ~ %s: %s (index %d) -> %s (index %d)\n", p.Label, p.OldType, p.OldIndex, p.NewType, p.NewIndex)
+			}
+		}
+	}
+	if len(d.Messages) > 0 {
+		b.WriteString("messages:\n")
+		for _, m := range d.Messages {
+			key := m.Key
+			if m.Parameter != "" {
+				key = m.Parameter + "." + m.Key
+			}
+			switch m.Kind {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "  + %s [%s]: %q\n", key, m.Tag, m.New)
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "  - %s [%s]: %q\n", key, m.Tag, m.Old)
+			default:
+				fmt.Fprintf(&b, "  ~ %s [%s]: %q -> %q\n", key, m.Tag, m.Old, m.New)
+			}
+		}
+	}
+	if len(d.Dependencies) > 0 {
+		b.WriteString("dependencies:\n")
+		for _, dep := range d.Dependencies {
+			switch dep.Kind {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "  + %s on %s: %s\n", dep.Contract, dep.Network, dep.NewAddress)
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "  - %s on %s: %s\n", dep.Contract, dep.Network, dep.OldAddress)
+			default:
+				fmt.Fprintf(&b, "  ~ %s on %s:", dep.Contract, dep.Network)
+				if dep.OldAddress != dep.NewAddress {
+					fmt.Fprintf(&b, " address %s -> %s", dep.OldAddress, dep.NewAddress)
+				}
+				if dep.OldPin != dep.NewPin {
+					fmt.Fprintf(&b, " pin %s -> %s", dep.OldPin, dep.NewPin)
+				}
+				if dep.OldBlockHeight != dep.NewBlockHeight {
+					fmt.Fprintf(&b, " block height %d -> %d", dep.OldBlockHeight, dep.NewBlockHeight)
+				}
+				b.WriteString("\n")
+			}
+		}
+	}
+	if len(d.NetworkPins) > 0 {
+		b.WriteString("network pins:\n")
+		for _, p := range d.NetworkPins {
+			switch p.Kind {
+			case ChangeAdded:
+				fmt.Fprintf(&b, "  + %s: %s\n", p.Network, p.New)
+			case ChangeRemoved:
+				fmt.Fprintf(&b, "  - %s: %s\n", p.Network, p.Old)
+			default:
+				fmt.Fprintf(&b, "  ~ %s: %s -> %s\n", p.Network, p.Old, p.New)
+			}
+		}
+	}
+	return b.String()
+}
+
+type diffParameter struct {
+	index    int
+	cadType  string
+	messages map[string]map[string]string
+}
+
+type diffNetwork struct {
+	address     string
+	pin         string
+	blockHeight uint64
+}
+
+/*
+Version independent view of a template used for comparing
+*/
+type diffTemplate struct {
+	version      string
+	id           string
+	cadence      string
+	parameters   map[string]diffParameter
+	messages     map[string]map[string]string
+	dependencies map[string]map[string]diffNetwork
+	networkPins  map[string]string
+}
+
+// DiffTemplates compares two v1.0 or v1.1 templates, versions can be mixed
+func DiffTemplates(oldTemplate string, newTemplate string) (*TemplateDiff, error) {
+	a, err := toDiffTemplate(oldTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse old template: %w", err)
+	}
+	b, err := toDiffTemplate(newTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse new template: %w", err)
+	}
+
+	cadenceDiff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(a.cadence),
+		B:        difflib.SplitLines(b.cadence),
+		FromFile: "old",
+		ToFile:   "new",
+		Context:  3,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &TemplateDiff{
+		OldID:       a.id,
+		NewID:       b.id,
+		OldVersion:  a.version,
+		NewVersion:  b.version,
+		CadenceDiff: cadenceDiff,
+	}
+
+	for _, label := range unionKeys(a.parameters, b.parameters) {
+		oldParam, inOld := a.parameters[label]
+		newParam, inNew := b.parameters[label]
+		change := ParameterChange{
+			Label:    label,
+			OldType:  oldParam.cadType,
+			NewType:  newParam.cadType,
+			OldIndex: oldParam.index,
+			NewIndex: newParam.index,
+		}
+		switch {
+		case !inOld:
+			change.Kind = ChangeAdded
+		case !inNew:
+			change.Kind = ChangeRemoved
+		case oldParam.cadType != newParam.cadType || oldParam.index != newParam.index:
+			change.Kind = ChangeModified
+		}
+		if change.Kind != "" {
+			diff.Parameters = append(diff.Parameters, change)
+		}
+		diff.Messages = append(diff.Messages, diffMessages(label, oldParam.messages, newParam.messages)...)
+	}
+	diff.Messages = append(diffMessages("", a.messages, b.messages), diff.Messages...)
+
+	for _, contract := range unionKeys(a.dependencies, b.dependencies) {
+		oldNetworks, newNetworks := a.dependencies[contract], b.dependencies[contract]
+		for _, network := range unionKeys(oldNetworks, newNetworks) {
+			oldNetwork, inOld := oldNetworks[network]
+			newNetwork, inNew := newNetworks[network]
+			change := DependencyChange{
+				Contract:       contract,
+				Network:        network,
+				OldAddress:     oldNetwork.address,
+				NewAddress:     newNetwork.address,
+				OldPin:         oldNetwork.pin,
+				NewPin:         newNetwork.pin,
+				OldBlockHeight: oldNetwork.blockHeight,
+				NewBlockHeight: newNetwork.blockHeight,
+			}
+			switch {
+			case !inOld:
+				change.Kind = ChangeAdded
+			case !inNew:
+				change.Kind = ChangeRemoved
+			case oldNetwork != newNetwork:
+				change.Kind = ChangeModified
+			}
+			if change.Kind != "" {
+				diff.Dependencies = append(diff.Dependencies, change)
+			}
+		}
+	}
+
+	for _, network := range unionKeys(a.networkPins, b.networkPins) {
+		oldPin, inOld := a.networkPins[network]
+		newPin, inNew := b.networkPins[network]
+		change := NetworkPinChange{Network: network, Old: oldPin, New: newPin}
+		switch {
+		case !inOld:
+			change.Kind = ChangeAdded
+		case !inNew:
+			change.Kind = ChangeRemoved
+		case oldPin != newPin:
+			change.Kind = ChangeModified
+		}
+		if change.Kind != "" {
+			diff.NetworkPins = append(diff.NetworkPins, change)
+		}
+	}
+
+	return diff, nil
+}
+
+func diffMessages(parameter string, a map[string]map[string]string, b map[string]map[string]string) []MessageChange {
+	var changes []MessageChange
+	for _, key := range unionKeys(a, b) {
+		for _, tag := range unionKeys(a[key], b[key]) {
+			oldValue, inOld := a[key][tag]
+			newValue, inNew := b[key][tag]
+			change := MessageChange{Parameter: parameter, Key: key, Tag: tag, Old: oldValue, New: newValue}
+			switch {
+			case !inOld:
+				change.Kind = ChangeAdded
+			case !inNew:
+				change.Kind = ChangeRemoved
+			case oldValue != newValue:
+				change.Kind = ChangeModified
+			}
+			if change.Kind != "" {
+				changes = append(changes, change)
+			}
+		}
+	}
+	return changes
+}
+
+func unionKeys[V any](a map[string]V, b map[string]V) []string {
+	seen := make(map[string]bool)
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func toDiffTemplate(template string) (*diffTemplate, error) {
+	ver, err := getTemplateVersion(template)
+	if err != nil {
+		return nil, fmt.Errorf("invalid flix template version, %w", err)
+	}
+	switch ver {
+	case "1.1.0":
+		flix, err := v1_1.ParseFlix(template)
+		if err != nil {
+			return nil, err
+		}
+		return diffTemplateV1_1(flix), nil
+	case "1.0.0":
+		flix, err := v1.ParseFlix(template)
+		if err != nil {
+			return nil, err
+		}
+		return diffTemplateV1_0(flix), nil
+	default:
+		return nil, fmt.Errorf("flix template version: %s not supported", ver)
+	}
+}
+
+func messagesV1_1(msgs []v1_1.Message) map[string]map[string]string {
+	m := make(map[string]map[string]string)
+	for _, msg := range msgs {
+		if m[msg.Key] == nil {
+			m[msg.Key] = make(map[string]string)
+		}
+		for _, i18n := range msg.I18n {
+			m[msg.Key][i18n.Tag] = i18n.Translation
+		}
+	}
+	return m
+}
+
+func diffTemplateV1_1(flix *v1_1.InteractionTemplate) *diffTemplate {
+	t := &diffTemplate{
+		version:      flix.FVersion,
+		id:           flix.ID,
+		cadence:      flix.Data.Cadence.Body,
+		parameters:   make(map[string]diffParameter),
+		messages:     messagesV1_1(flix.Data.Messages),
+		dependencies: make(map[string]map[string]diffNetwork),
+		networkPins:  make(map[string]string),
+	}
+	for _, p := range flix.Data.Parameters {
+		t.parameters[p.Label] = diffParameter{
+			index:    p.Index,
+			cadType:  p.Type,
+			messages: messagesV1_1(p.Messages),
+		}
+	}
+	for _, dep := range flix.Data.Dependencies {
+		for _, contract := range dep.Contracts {
+			if t.dependencies[contract.Contract] == nil {
+				t.dependencies[contract.Contract] = make(map[string]diffNetwork)
+			}
+			for _, network := range contract.Networks {
+				n := diffNetwork{
+					address:     network.Address,
+					blockHeight: network.DependencyPinBlockHeight,
+				}
+				if network.DependencyPin != nil {
+					n.pin = network.DependencyPin.Pin
+				}
+				t.dependencies[contract.Contract][network.Network] = n
+			}
+		}
+	}
+	for _, pin := range flix.Data.Cadence.NetworkPins {
+		t.networkPins[pin.Network] = pin.PinSelf
+	}
+	return t
+}
+
+func messagesV1_0(msgs v1.Messages) map[string]map[string]string {
+	m := make(map[string]map[string]string)
+	if msgs.Title != nil && len(msgs.Title.I18N) > 0 {
+		m["title"] = msgs.Title.I18N
+	}
+	if msgs.Description != nil && len(msgs.Description.I18N) > 0 {
+		m["description"] = msgs.Description.I18N
+	}
+	return m
+}
+
+func diffTemplateV1_0(flix *v1.FlowInteractionTemplate) *diffTemplate {
+	t := &diffTemplate{
+		version:      flix.FVersion,
+		id:           flix.ID,
+		cadence:      flix.Data.Cadence,
+		parameters:   make(map[string]diffParameter),
+		messages:     messagesV1_0(flix.Data.Messages),
+		dependencies: make(map[string]map[string]diffNetwork),
+	}
+	for label, arg := range flix.Data.Arguments {
+		t.parameters[label] = diffParameter{
+			index:    arg.Index,
+			cadType:  arg.Type,
+			messages: messagesV1_0(arg.Messages),
+		}
+	}
+	// v1.0 keys contracts by address placeholder, compare by contract name like v1.1
+	for _, contracts := range flix.Data.Dependencies {
+		for contractName, networks := range contracts {
+			if t.dependencies[contractName] == nil {
+				t.dependencies[contractName] = make(map[string]diffNetwork)
+			}
+			for networkName, network := range networks {
+				t.dependencies[contractName][networkName] = diffNetwork{
+					address:     network.Address,
+					pin:         network.Pin,
+					blockHeight: network.PinBlockHeight,
+				}
+			}
+		}
+	}
+	return t
+}
@@ -0,0 +1,85 @@
+package internal
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+
+	v1 "github.com/onflow/flixkit-go/v2/internal/v1"
+	v1_1 "github.com/onflow/flixkit-go/v2/internal/v1_1"
+)
+
+func TestDiffTemplatesNoChanges(t *testing.T) {
+	assert := assert.New(t)
+
+	diff, err := DiffTemplates(ReadTokenScript, ReadTokenScript)
+	assert.NoError(err, "DiffTemplates should not return an error")
+	assert.False(diff.HasChanges(), "identical templates should not have changes")
+	assert.Equal("no changes\n", diff.String())
+}
+
+func TestDiffTemplatesV1_1(t *testing.T) {
+	assert := assert.New(t)
+
+	flix, err := v1_1.ParseFlix(ReadTokenScript)
+	assert.NoError(err, "ParseFlix should not return an error")
+	flix.ID = "updated"
+	flix.Data.Cadence.Body = strings.Replace(flix.Data.Cadence.Body, "return vaultRef.balance", "return vaultRef.balance * 2.0", 1)
+	flix.Data.Parameters[0].Type = "String"
+	flix.Data.Parameters = append(flix.Data.Parameters, v1_1.Parameter{Label: "factor", Index: 1, Type: "UFix64"})
+	flix.Data.Messages = []v1_1.Message{{Key: "title", I18n: []v1_1.I18n{{Tag: "en-US", Translation: "Read Token Balance"}}}}
+	flix.Data.Dependencies[1].Contracts[0].Networks[0].Address = "0x0000000000000001"
+	flix.Data.Dependencies[1].Contracts[0].Networks = flix.Data.Dependencies[1].Contracts[0].Networks[:2]
+	flix.Data.Cadence.NetworkPins[1].PinSelf = "changed"
+	updated, err := json.Marshal(flix)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	diff, err := DiffTemplates(ReadTokenScript, string(updated))
+	assert.NoError(err, "DiffTemplates should not return an error")
+	assert.True(diff.IDChanged())
+	assert.True(diff.CadenceChanged())
+	assert.Len(diff.Parameters, 2)
+	assert.Len(diff.Messages, 1)
+	assert.Len(diff.Dependencies, 2)
+	assert.Len(diff.NetworkPins, 1)
+	autogold.ExpectFile(t, diff.String())
+}
+
+func TestDiffTemplatesV1_0(t *testing.T) {
+	assert := assert.New(t)
+
+	flix, err := v1.ParseFlix(flix_template)
+	assert.NoError(err, "ParseFlix should not return an error")
+	flix.Data.Messages.Title.I18N = map[string]string{"en-US": "Transfer Tokens", "fr-FR": "Transférer des jetons"}
+	network := flix.Data.Dependencies["0xFUNGIBLETOKENADDRESS"]["FungibleToken"]["testnet"]
+	network.Pin = "changed"
+	network.PinBlockHeight = 1
+	flix.Data.Dependencies["0xFUNGIBLETOKENADDRESS"]["FungibleToken"]["testnet"] = network
+	updated, err := json.Marshal(flix)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	diff, err := DiffTemplates(flix_template, string(updated))
+	assert.NoError(err, "DiffTemplates should not return an error")
+	assert.False(diff.IDChanged())
+	assert.False(diff.CadenceChanged())
+	assert.Equal([]MessageChange{{Kind: ChangeAdded, Key: "title", Tag: "fr-FR", New: "Transférer des jetons"}}, diff.Messages)
+	assert.Equal([]DependencyChange{{
+		Kind:           ChangeModified,
+		Contract:       "FungibleToken",
+		Network:        "testnet",
+		OldAddress:     "0x9a0766d93b6608b7",
+		NewAddress:     "0x9a0766d93b6608b7",
+		OldPin:         "83c9e3d61d3b5ebf24356a9f17b5b57b12d6d56547abc73e05f820a0ae7d9cf5",
+		NewPin:         "changed",
+		OldBlockHeight: 74776482,
+		NewBlockHeight: 1,
+	}}, diff.Dependencies)
+}
+
+func TestDiffTemplatesInvalid(t *testing.T) {
+	_, err := DiffTemplates(ReadTokenScript, `{"f_version": "2.0.0"}`)
+	assert.Error(t, err, "DiffTemplates should return an error for unsupported versions")
+}
@@ -0,0 +1,57 @@
+package templates
+
+func GetFclBundleTemplate() string {
+	const template = `{{define "bundleHeader"}}/**
+    This binding file was auto generated based on FLIX templates.
+    Changes to this file might get overwritten.
+    Note fcl version {{.FclVersion}} or higher is required to use templates.
+{{- range .ProvenanceLines}}
+    {{.}}
+{{- end}}
+**/
+{{end}}
+
+{{define "templateImport"}}
+{{- if .EmbeddedTemplate -}}
+const {{.TemplateVar}} = {{.EmbeddedTemplate}}
+{{- else if .IsLocalTemplate -}}
+import {{.TemplateVar}} from "{{.Location}}"
+{{- else -}}
+const {{.TemplateVar}} = "{{.Location}}"
+{{- end -}}
+{{end}}
+
+{{define "bundle"}}{{template "bundleHeader" .}}
+import * as fcl from "@onflow/fcl"
+{{- range .Templates}}
+{{template "templateImport" .}}
+{{- end}}
+{{template "validators" .Validators}}{{range .Templates}}
+{{if $.Typed}}{{template "interface" .}}{{template "outputInterfaces" .}}{{end}}{{template "function" .}}
+{{end}}{{end}}
+
+{{define "module"}}{{template "bundleHeader" .}}
+import * as fcl from "@onflow/fcl"
+{{- if and .Typed (len .Parameters)}}
+import type { {{.ParametersPrefixName}}Params } from "./types"
+{{- end}}
+{{template "templateImport" .}}
+
+{{template "validators" .Validators}}{{if .Typed}}{{template "outputInterfaces" .}}{{end}}{{template "function" .}}
+{{end}}
+
+{{define "types"}}{{template "bundleHeader" .}}
+{{range .Templates}}{{if len .Parameters}}{{template "interface" .}}{{end}}{{end}}{{end}}
+
+{{define "index"}}{{template "bundleHeader" .}}
+{{- if .Typed}}
+export * from "./types"
+{{- end}}
+{{- range .Templates}}
+export * from "./{{.Title}}"
+{{- end}}
+{{end}}
+`
+
+	return template
+}
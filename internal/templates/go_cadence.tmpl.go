@@ -0,0 +1,32 @@
+package templates
+
+func GetGoCadenceTemplate() string {
+	const template = `{{define "cadence"}}
+{{- if .NetworkCadence -}}
+// {{.Title}}Cadence is the template Cadence with imports replaced by the contract addresses of each network
+var {{.Title}}Cadence = map[string]string{
+{{- range .NetworkCadence}}
+	"{{.Network}}": {{goString .Cadence}},
+{{- end}}
+}
+
+func {{.Title}}Code(network string) ([]byte, error) {
+	code, ok := {{.Title}}Cadence[network]
+	if !ok {
+		return nil, fmt.Errorf("network %s not found in template dependencies", network)
+	}
+	return []byte(code), nil
+}
+{{- else -}}
+// {{.Title}}Cadence is the template Cadence, it has no dependencies and is the same on every network
+const {{.Title}}Cadence = {{goString .Cadence}}
+
+func {{.Title}}Code(network string) ([]byte, error) {
+	return []byte({{.Title}}Cadence), nil
+}
+{{- end}}
+{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,28 @@
+package templates
+
+func GetGoMainTemplate() string {
+	const template = `// Code generated by flixkit based on FLIX template v{{.Version}}. DO NOT EDIT.
+{{- range .ProvenanceLines}}
+// {{.}}
+{{- end}}
+// The Cadence of each network is embedded, regenerate this file when the template changes.
+
+package {{.PackageName}}
+
+import (
+{{- range goImports .}}
+	{{.}}
+{{- end}}
+)
+
+{{template "cadence" .}}
+{{template "arguments" .}}
+{{if .IsScript -}}
+{{template "script" .}}
+{{- else -}}
+{{template "tx" .}}
+{{- end}}
+`
+
+	return template
+}
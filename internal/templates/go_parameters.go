@@ -0,0 +1,20 @@
+package templates
+
+func GetGoParamsTemplate() string {
+	const template = `{{define "params"}}
+{{- range .Parameters}}, {{goName .Name}} {{goType .Type}}{{end -}}
+{{end}}
+{{define "names"}}
+{{- range $index, $ele := .Parameters}}{{if $index}}, {{end}}{{goName $ele.Name}}{{end -}}
+{{end}}
+{{define "arguments"}}
+{{- if len .Parameters -}}
+func encode{{.ParametersPrefixName}}Arguments({{range $index, $ele := .Parameters}}{{if $index}}, {{end}}{{goName $ele.Name}} {{goType $ele.Type}}{{end}}) ([]cadence.Value, error) {
+{{goEncode .Parameters}}
+}
+{{end}}
+{{- end}}
+`
+
+	return template
+}
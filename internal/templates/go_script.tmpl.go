@@ -0,0 +1,33 @@
+package templates
+
+func GetGoScriptTemplate() string {
+	const template = `{{define "script"}}// {{.ParametersPrefixName}} executes the template script on the network and decodes the result
+{{- if .Description}}
+// {{goComment .Description}}
+{{- end}}
+func {{.ParametersPrefixName}}(ctx context.Context, client access.Client, network string{{template "params" .}}) ({{goOutputType .Output.Type}}, error) {
+	var result {{goOutputType .Output.Type}}
+	code, err := {{.Title}}Code(network)
+	if err != nil {
+		return result, err
+	}
+{{- if len .Parameters}}
+	args, err := encode{{.ParametersPrefixName}}Arguments({{template "names" .}})
+	if err != nil {
+		return result, err
+	}
+	value, err := client.ExecuteScriptAtLatestBlock(ctx, code, args)
+{{- else}}
+	value, err := client.ExecuteScriptAtLatestBlock(ctx, code, nil)
+{{- end}}
+	if err != nil {
+		return result, err
+	}
+	{{goDecode .Output.Type "value" "result"}}
+	return result, nil
+}
+{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,33 @@
+package templates
+
+func GetGoTxTemplate() string {
+	const template = `{{define "tx"}}// {{.ParametersPrefixName}} builds the template transaction for the network, the caller sets the reference block,
+// proposer, payer and authorizers before signing
+{{- if .Description}}
+// {{goComment .Description}}
+{{- end}}
+func {{.ParametersPrefixName}}(network string{{template "params" .}}) (*flow.Transaction, error) {
+	code, err := {{.Title}}Code(network)
+	if err != nil {
+		return nil, err
+	}
+	tx := flow.NewTransaction().SetScript(code)
+{{- if len .Parameters}}
+	args, err := encode{{.ParametersPrefixName}}Arguments({{template "names" .}})
+	if err != nil {
+		return nil, err
+	}
+	for _, arg := range args {
+		err = tx.AddArgument(arg)
+		if err != nil {
+			return nil, err
+		}
+	}
+{{- end}}
+	return tx, nil
+}
+{{end}}
+`
+
+	return template
+}
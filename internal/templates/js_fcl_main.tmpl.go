@@ -5,15 +5,29 @@ func GetJsFclMainTemplate() string {
     This binding file was auto generated based on FLIX template v{{.Version}}. 
     Changes to this file might get overwritten.
     Note fcl version {{.FclVersion}} or higher is required to use templates. 
+{{- range .ProvenanceLines}}
+    {{.}}
+{{- end}}
 **/
 
 import * as fcl from "@onflow/fcl"
-{{- if .IsLocalTemplate }}
-import flixTemplate from "{{.Location}}"
+{{- if .DecimalImport }}
+import Decimal from "decimal.js"
+{{- end}}
+{{- if .EmbeddedTemplate }}
+// template {{.ID}} is embedded, its id was verified when this file was generated
+const {{.TemplateVar}} = {{.EmbeddedTemplate}}
+{{- else if .IsLocalTemplate }}
+import {{.TemplateVar}} from "{{.Location}}"
 {{- else}}
-const flixTemplate = "{{.Location}}"
+const {{.TemplateVar}} = "{{.Location}}"
 {{- end}}
-{{"\n"}}/**
+{{"\n"}}{{template "validators" .Validators}}{{template "function" .}}{{if .IsScript}}{{"\n"}}{{end}}
+
+
+
+
+{{define "function"}}/**
 * {{.Description}}{{"\n"}}
    {{- if gt (len .Parameters) 0 -}}
 * @param {Object} Parameters - parameters for the cadence
@@ -29,14 +43,9 @@ const flixTemplate = "{{.Location}}"
 {{- "\n"}}*/
 {{if .IsScript}}
 {{- template "script" .}}
-{{ else }}
+{{- else }}
 {{- template "tx" .}}
-{{- end}}
-
-
-
-
-`
+{{- end}}{{end}}`
 
 	return template
 }
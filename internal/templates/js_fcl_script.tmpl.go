@@ -3,18 +3,19 @@ package templates
 func GetJsFclScriptTemplate() string {
 	const template = `{{define "script"}}export async function {{.Title}}( 
 {{- template "params" .}}) {
+{{- template "validate" .}}
   const info = await fcl.query({
-    template: flixTemplate,
+    template: {{.TemplateVar}},
     {{ if len .Parameters -}}
     args: (arg, t) => [
       {{- range $index, $ele := .Parameters -}}
-        {{if $index}}, {{end}}arg({{.Name}}, t.{{.FclType}})
+        {{if $index}}, {{end}}arg({{.JsArg}}, t.{{.FclType}})
       {{- end -}}
       ]
     {{- end }}
   });
 
-  return info
+  return {{.OutputDecode}}
 }{{end}}
 `
 
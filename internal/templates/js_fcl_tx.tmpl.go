@@ -3,19 +3,44 @@ package templates
 func GetJsFclTxTemplate() string {
 	const template = `{{define "tx"}}export async function {{.Title}}(
   {{- template "params" .}}) {
+{{- template "validate" .}}
   const transactionId = await fcl.mutate({
-    template: flixTemplate,
+    template: {{.TemplateVar}},
     {{ if len .Parameters -}}
     args: (arg, t) => [
       {{- range $index, $ele := .Parameters -}}
-        {{if $index}}, {{end}}arg({{.Name}}, t.{{.FclType}})
+        {{if $index}}, {{end}}arg({{.JsArg}}, t.{{.FclType}})
       {{- end -}}
       ]
     {{- end }}
   });
 
   return transactionId
-}{{end}}
+}
+{{- if .TxResults}}
+
+/**
+* {{.Title}}Sealed: sends the transaction and waits for it to be sealed
+* @returns {Promise<Object>} - returns a promise which resolves to the transaction id, blockId, statusCode, errorMessage and the decoded events
+*/
+export async function {{.Title}}Sealed(
+  {{- template "params" .}}) {
+  const transactionId = await {{.Title}}({{template "paramNames" .}});
+  const status = await fcl.tx(transactionId).onceSealed();
+
+  return {
+    transactionId,
+    blockId: status.blockId,
+    statusCode: status.statusCode,
+    errorMessage: status.errorMessage,
+    events: {
+      {{- range .Events}}
+      {{.Key}}: status.events.filter((e) => e.type.endsWith(".{{.Type}}")).map((e) => {{.Decode}}),
+      {{- end}}
+    },
+  };
+}
+{{- end}}{{end}}
 `
 
 	return template
@@ -0,0 +1,51 @@
+package templates
+
+func GetKotlinMainTemplate() string {
+	const template = `/**
+ * This binding file was auto generated based on FLIX template v{{.Version}}.
+ * Changes to this file might get overwritten.
+{{- range .ProvenanceLines}}
+ * {{.}}
+{{- end}}
+ * The Cadence of each network is embedded, regenerate this file when the template changes.
+ */
+package {{.PackageName}}
+
+import com.google.protobuf.ByteString
+import java.math.BigDecimal
+import java.math.BigInteger
+import kotlinx.coroutines.Dispatchers
+import kotlinx.coroutines.withContext
+import org.onflow.flow.sdk.*
+import org.onflow.flow.sdk.cadence.*
+{{template "params" .}}
+/**
+ * {{if .Description}}{{.Description}}{{else}}{{.Title}}{{end}}
+ */
+object {{.ParametersPrefixName}} {
+    {{template "cadence" .}}
+{{- if len .Parameters}}
+
+    /** Converts the parameters to Cadence arguments */
+    fun encode(params: {{.ParametersPrefixName}}Params): List<Field<*>> = listOf(
+{{- range .Parameters}}
+        {{kotlinValue .Type (printf "params.%s" .Name)}},
+{{- end}}
+    )
+{{- end}}
+
+{{if .IsScript -}}
+{{template "script" .}}
+{{- else -}}
+{{template "tx" .}}
+{{- end}}
+}
+
+private fun <T> FlowAccessApi.AccessApiCallResponse<T>.unwrap(): T = when (this) {
+    is FlowAccessApi.AccessApiCallResponse.Success -> data
+    is FlowAccessApi.AccessApiCallResponse.Error -> throw IllegalStateException(message, throwable)
+}
+`
+
+	return template
+}
@@ -0,0 +1,44 @@
+package templates
+
+func GetKotlinParamsTemplate() string {
+	const template = `{{define "params"}}
+{{- if len .Parameters}}
+/**
+ * Parameters of {{.ParametersPrefixName}}
+{{- range .Parameters}}
+{{- if .Description}}
+ * @property {{.Name}} {{.Description}}
+{{- end}}
+{{- end}}
+ */
+data class {{.ParametersPrefixName}}Params(
+{{- range .Parameters}}
+    val {{.Name}}: {{kotlinType .Type}},
+{{- end}}
+)
+{{end}}
+{{- end}}
+{{define "cadence"}}
+{{- if .NetworkCadence -}}
+/** The template Cadence with imports replaced by the contract addresses of each network */
+    private val networkCadence = mapOf(
+{{- range .NetworkCadence}}
+        "{{.Network}}" to {{kotlinString .Cadence}},
+{{- end}}
+    )
+
+    /** Returns the template Cadence of the network */
+    fun code(network: String): String =
+        networkCadence[network] ?: throw IllegalArgumentException("network $network not found in template dependencies")
+{{- else -}}
+/** The template Cadence, it has no dependencies and is the same on every network */
+    private const val TEMPLATE_CADENCE = {{kotlinString .Cadence}}
+
+    /** Returns the template Cadence of the network */
+    fun code(network: String): String = TEMPLATE_CADENCE
+{{- end}}
+{{- end}}
+`
+
+	return template
+}
@@ -0,0 +1,15 @@
+package templates
+
+func GetKotlinScriptTemplate() string {
+	const template = `{{define "script"}}    /** Executes the template script on the network at the latest sealed block */
+    suspend fun query(api: FlowAccessApi, network: String{{if len .Parameters}}, params: {{.ParametersPrefixName}}Params{{end}}): FlowScriptResponse = withContext(Dispatchers.IO) {
+        api.executeScriptAtLatestBlock(
+            FlowScript(code(network)),
+            {{if len .Parameters}}encode(params).map { ByteString.copyFrom(Flow.encodeJsonCadence(it)) }{{else}}emptyList(){{end}},
+        ).unwrap()
+    }
+{{- end}}
+`
+
+	return template
+}
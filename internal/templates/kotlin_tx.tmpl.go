@@ -0,0 +1,34 @@
+package templates
+
+func GetKotlinTxTemplate() string {
+	const template = `{{define "tx"}}    /**
+     * Builds the template transaction on the network with the signer as proposer, payer{{if .Authorizers}} and authorizer{{end}},
+     * sign it with addEnvelopeSignature before sending
+     */
+    suspend fun transaction(
+        api: FlowAccessApi,
+        network: String,
+{{- if len .Parameters}}
+        params: {{.ParametersPrefixName}}Params,
+{{- end}}
+        signer: FlowAddress,
+        keyIndex: Int = 0,
+        gasLimit: Long = 9999,
+    ): FlowTransaction = withContext(Dispatchers.IO) {
+        val block = api.getLatestBlockHeader(true).unwrap()
+        val account = api.getAccountAtLatestBlock(signer).unwrap()
+        FlowTransaction(
+            script = FlowScript(code(network)),
+            arguments = {{if len .Parameters}}encode(params).map { FlowArgument(it) }{{else}}emptyList(){{end}},
+            referenceBlockId = block.id,
+            gasLimit = gasLimit,
+            proposalKey = FlowTransactionProposalKey(signer, keyIndex, account.keys[keyIndex].sequenceNumber.toLong()),
+            payerAddress = signer,
+            authorizers = List({{.Authorizers}}) { signer },
+        )
+    }
+{{- end}}
+`
+
+	return template
+}
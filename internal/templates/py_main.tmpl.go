@@ -0,0 +1,65 @@
+package templates
+
+func GetPyMainTemplate() string {
+	const template = `"""
+    This binding file was auto generated based on FLIX template v{{.Version}}.
+    Changes to this file might get overwritten.
+    Note flow-py-sdk is required to use templates.
+{{- range .ProvenanceLines}}
+    {{.}}
+{{- end}}
+"""
+
+import json
+import re
+{{- if pythonDecimal .Parameters}}
+from decimal import Decimal
+{{- end}}
+from functools import lru_cache
+{{- if .EmbeddedTemplate}}
+{{- else if .IsLocalTemplate}}
+from pathlib import Path
+{{- else}}
+from urllib.request import urlopen
+{{- end}}
+
+from flow_py_sdk import cadence, {{if .IsScript}}Script{{else}}ProposalKey, Tx{{end}}
+from flow_py_sdk.client import AccessAPI
+{{- if not .IsScript}}
+from flow_py_sdk.signer import Signer
+{{- end}}
+
+{{if .EmbeddedTemplate -}}
+# template {{.ID}} is embedded, its id was verified when this file was generated
+FLIX_TEMPLATE = {{pythonString .EmbeddedTemplate}}
+{{- else if .IsLocalTemplate -}}
+FLIX_TEMPLATE = Path(__file__).parent / "{{.Location}}"
+{{- else -}}
+FLIX_TEMPLATE = "{{.Location}}"
+{{- end}}
+
+
+@lru_cache(maxsize=None)
+def _flix_template() -> dict:
+{{- if .EmbeddedTemplate}}
+    return json.loads(FLIX_TEMPLATE)
+{{- else if .IsLocalTemplate}}
+    return json.loads(FLIX_TEMPLATE.read_text())
+{{- else}}
+    with urlopen(FLIX_TEMPLATE) as response:
+        return json.load(response)
+{{- end}}
+
+
+{{template "resolve" .}}
+
+
+{{if .IsScript -}}
+{{template "script" .}}
+{{- else -}}
+{{template "tx" .}}
+{{- end}}
+`
+
+	return template
+}
@@ -0,0 +1,36 @@
+package templates
+
+func GetPyParamsTemplate() string {
+	const template = `{{define "params"}}
+{{- range .Parameters}}, {{snakeCase .Name}}: {{pythonType .Type}}{{end -}}
+{{end}}
+{{define "args"}}
+{{- range $index, $ele := .Parameters}}{{if $index}}, {{end}}{{pythonValue $ele.Type (snakeCase $ele.Name)}}{{end -}}
+{{end}}
+{{define "docstring"}}    """{{if .Description}}{{.Description}}{{else}}{{.Title}}{{end}}
+{{- if or (len .Parameters) (gt .Authorizers 1)}}
+
+    Args:
+{{- if gt .Authorizers 1}}
+        co_authorizers: address, key id and signer of the authorizers after the signer, they sign the payload
+{{- end}}
+{{- range .Parameters}}
+        {{snakeCase .Name}} ({{.Type}}){{if .Description}}: {{.Description}}{{end}}
+{{- end}}
+{{- end}}
+{{- if .IsScript}}
+{{- if .Output.Type}}
+
+    Returns:
+        cadence.Value ({{.Output.Type}}){{if .Output.Description}}: {{.Output.Description}}{{end}}
+{{- end}}
+{{- else}}
+
+    Returns:
+        The transaction id, the signer is proposer, payer{{if .Authorizers}} and {{if gt .Authorizers 1}}first {{end}}authorizer{{end}}
+{{- end}}
+    """{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,43 @@
+package templates
+
+func GetPyResolveTemplate() string {
+	const template = `{{define "resolve"}}def _cadence(network: str) -> str:
+    """Returns the template Cadence with imports replaced by the contract addresses of the network"""
+    data = _flix_template()["data"]
+{{- if eq .Version "1.0.0"}}
+    dependencies = data.get("dependencies") or {}
+
+    def replace(match: re.Match) -> str:
+        name, placeholder = match.group(1), match.group(2)
+        networks = dependencies.get(placeholder, {}).get(name)
+        if networks is None:
+            raise ValueError(f"contract {name} not found in dependencies")
+        if network not in networks:
+            raise ValueError(f"network {network} not found for contract {name} in dependencies")
+        return f"import {name} from {networks[network]['address']}"
+
+    return re.sub(r"import\s*(\w+)\s*from\s*(0x\w+)", replace, data["cadence"])
+{{- else}}
+    addresses = {
+        contract["contract"]: contract_network["address"]
+        for dependency in data.get("dependencies") or []
+        for contract in dependency["contracts"]
+        for contract_network in contract["networks"]
+        if contract_network["network"] == network
+    }
+
+    def replace(match: re.Match) -> str:
+        identifiers, name = match.group(1) or match.group(2), match.group(2)
+        if name == "Crypto":
+            # built-in contracts are imported by name
+            return f"import {name}"
+        if name not in addresses:
+            raise ValueError(f"network {network} not found for contract {name} in dependencies")
+        return f"import {identifiers} from {addresses[name]}"
+
+    return re.sub(r'import\s*(?:(\w+(?:\s*,\s*\w+)*)\s+from\s*)?"(\w+)"', replace, data["cadence"]["body"])
+{{- end}}{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,12 @@
+package templates
+
+func GetPyScriptTemplate() string {
+	const template = `{{define "script"}}async def {{snakeCase .Title}}(client: AccessAPI{{template "params" .}}, *, network: str = "mainnet") -> cadence.Value:
+{{template "docstring" .}}
+    script = Script(code=_cadence(network), arguments=[{{template "args" .}}])
+    return await client.execute_script(script=script)
+{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,44 @@
+package templates
+
+func GetPyTxTemplate() string {
+	const template = `{{define "tx"}}async def {{snakeCase .Title}}(client: AccessAPI, signer_address: cadence.Address, signer: Signer
+	{{- if gt .Authorizers 1}}, co_authorizers: list[tuple[cadence.Address, int, Signer]]{{end}}{{template "params" .}}, *, key_id: int = 0, network: str = "mainnet") -> str:
+{{template "docstring" .}}
+{{- if gt .Authorizers 1}}
+    if len(co_authorizers) + 1 != {{.Authorizers}}:
+        raise ValueError(f"the transaction takes {{.Authorizers}} authorizers, the signer and {len(co_authorizers)} co-authorizers were given")
+{{- end}}
+    block = await client.get_latest_block(is_sealed=True)
+    account = await client.get_account_at_latest_block(address=signer_address.bytes)
+    tx = (
+        Tx(
+            code=_cadence(network),
+            reference_block_id=block.id,
+            payer=signer_address,
+            proposal_key=ProposalKey(
+                key_address=signer_address,
+                key_id=key_id,
+                key_sequence_number=account.keys[key_id].sequence_number,
+            ),
+        )
+{{- if len .Parameters}}
+        .add_arguments({{template "args" .}})
+{{- end}}
+{{- if eq .Authorizers 1}}
+        .add_authorizers(signer_address)
+{{- else if .Authorizers}}
+        .add_authorizers(signer_address, *[address for address, _, _ in co_authorizers])
+{{- end}}
+    )
+{{- if gt .Authorizers 1}}
+    for address, authorizer_key_id, authorizer in co_authorizers:
+        tx = tx.with_payload_signature(address, authorizer_key_id, authorizer)
+{{- end}}
+    tx = tx.with_envelope_signature(signer_address, key_id, signer)
+    response = await client.send_transaction(transaction=tx.to_signed_grpc())
+    return response.id.hex()
+{{end}}
+`
+
+	return template
+}
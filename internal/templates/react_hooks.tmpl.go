@@ -0,0 +1,136 @@
+package templates
+
+func GetReactMainTemplate() string {
+	const template = `/**
+    This binding file was auto generated based on FLIX template v{{.Version}}.
+    Changes to this file might get overwritten.
+    Note fcl version {{.FclVersion}} or higher is required to use templates.
+{{- range .ProvenanceLines}}
+    {{.}}
+{{- end}}
+**/
+
+import * as fcl from "@onflow/fcl"
+import { useCallback, useEffect, useState } from "react"
+{{- if .DecimalImport }}
+import Decimal from "decimal.js"
+{{- end}}
+{{- if .EmbeddedTemplate }}
+// template {{.ID}} is embedded, its id was verified when this file was generated
+const {{.TemplateVar}} = {{.EmbeddedTemplate}}
+{{- else if .IsLocalTemplate }}
+import {{.TemplateVar}} from "{{.Location}}"
+{{- else}}
+const {{.TemplateVar}} = "{{.Location}}"
+{{- end}}
+{{"\n"}}
+{{- template "interface" . -}}
+{{- template "outputInterfaces" . -}}
+{{template "validators" .Validators}}{{template "function" .}}
+
+{{template "hook" .}}
+`
+
+	return template
+}
+
+func GetReactHookTemplate() string {
+	const template = `{{define "hook"}}
+{{- if .IsScript}}{{template "scriptHook" .}}{{else}}{{template "txHook" .}}{{end}}
+{{- end}}
+
+{{define "scriptHook"}}/**
+* use{{.ParametersPrefixName}}: runs {{.Title}} and runs it again when the parameters change or refetch is called
+* @returns data - the script result, undefined until the first result
+* @returns loading - true while the script runs
+* @returns error - the error of the last run
+*/
+export function use{{.ParametersPrefixName}}(
+  {{- template "params" .}}) {
+  const [data, setData] = useState<{{.Output.JsType}} | undefined>(undefined);
+  const [loading, setLoading] = useState<boolean>(true);
+  const [error, setError] = useState<Error | null>(null);
+  const [runs, setRuns] = useState<number>(0);
+{{- if len .Parameters}}
+  // arrays and objects passed inline are new on every render, the script only runs again when their content changes
+  const paramsKey = JSON.stringify({{template "paramNames" .}}, (_, v) => (typeof v === "bigint" ? v.toString() : v));
+{{- end}}
+
+  useEffect(() => {
+    let active = true;
+    setLoading(true);
+    {{.Title}}({{template "paramNames" .}})
+      .then((result) => {
+        if (active) {
+          setData(result);
+          setError(null);
+        }
+      })
+      .catch((e) => {
+        if (active) setError(e instanceof Error ? e : new Error(String(e)));
+      })
+      .finally(() => {
+        if (active) setLoading(false);
+      });
+    return () => {
+      active = false;
+    };
+{{- if len .Parameters}}
+    // eslint-disable-next-line react-hooks/exhaustive-deps
+{{- end}}
+  }, [{{if len .Parameters}}paramsKey, {{end}}runs]);
+
+  const refetch = useCallback(() => setRuns((n) => n + 1), []);
+
+  return { data, loading, error, refetch };
+}{{end}}
+
+{{define "txHook"}}export interface TransactionStatus {
+  blockId: string;
+  status: number;
+  statusString: string;
+  statusCode: number;
+  errorMessage: string;
+  events: Array<{ type: string; data: any }>;
+}
+
+/**
+* use{{.ParametersPrefixName}}: mutate sends the transaction with {{.Title}} and the hook follows its status
+* @returns mutate - sends the transaction and resolves to the transaction ID
+* @returns transactionId - the ID of the last sent transaction
+* @returns status - the latest status of the last sent transaction
+* @returns error - the error sending the transaction or its error message once it failed
+*/
+export function use{{.ParametersPrefixName}}() {
+  const [transactionId, setTransactionId] = useState<string | null>(null);
+  const [status, setStatus] = useState<TransactionStatus | null>(null);
+  const [error, setError] = useState<Error | null>(null);
+
+  const mutate = useCallback(async ({{if len .Parameters}}params: {{.ParametersPrefixName}}Params{{end}}): Promise<string> => {
+    setError(null);
+    setStatus(null);
+    try {
+      const id = await {{.Title}}({{if len .Parameters}}params{{end}});
+      setTransactionId(id);
+      return id;
+    } catch (e) {
+      const err = e instanceof Error ? e : new Error(String(e));
+      setError(err);
+      throw err;
+    }
+  }, []);
+
+  useEffect(() => {
+    if (!transactionId) return;
+    return fcl.tx(transactionId).subscribe((s: TransactionStatus) => {
+      setStatus(s);
+      if (s.errorMessage) setError(new Error(s.errorMessage));
+    });
+  }, [transactionId]);
+
+  return { mutate, transactionId, status, error };
+}{{end}}
+`
+
+	return template
+}
@@ -0,0 +1,45 @@
+package templates
+
+func GetSwiftMainTemplate() string {
+	const template = `//
+//  This binding file was auto generated based on FLIX template v{{.Version}}.
+//  Changes to this file might get overwritten.
+{{- range .ProvenanceLines}}
+//  {{.}}
+{{- end}}
+//  The Cadence of each network is embedded, regenerate this file when the template changes.
+//
+
+import BigInt
+import Flow
+import Foundation
+{{template "params" .}}
+/// {{if .Description}}{{.Description}}{{else}}{{.Title}}{{end}}
+public enum {{.ParametersPrefixName}} {
+    public enum Error: Swift.Error {
+        case networkNotFound(String)
+    }
+
+    {{template "cadence" .}}
+{{- if len .Parameters}}
+
+    /// Converts the parameters to Cadence arguments
+    public static func encode(_ params: {{.ParametersPrefixName}}Params) -> [Flow.Cadence.FValue] {
+        [
+{{- range .Parameters}}
+            {{swiftValue .Type (printf "params.%s" .Name)}},
+{{- end}}
+        ]
+    }
+{{- end}}
+
+{{if .IsScript -}}
+{{template "script" .}}
+{{- else -}}
+{{template "tx" .}}
+{{- end}}
+}
+`
+
+	return template
+}
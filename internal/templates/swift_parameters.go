@@ -0,0 +1,52 @@
+package templates
+
+func GetSwiftParamsTemplate() string {
+	const template = `{{define "params"}}
+{{- if len .Parameters}}
+/// Parameters of {{.ParametersPrefixName}}
+public struct {{.ParametersPrefixName}}Params {
+{{- range .Parameters}}
+{{- if .Description}}
+    /// {{.Description}}
+{{- end}}
+    public let {{.Name}}: {{swiftType .Type}}
+{{- end}}
+
+    public init({{range $index, $ele := .Parameters}}{{if $index}}, {{end}}{{$ele.Name}}: {{swiftType $ele.Type}}{{end}}) {
+{{- range .Parameters}}
+        self.{{.Name}} = {{.Name}}
+{{- end}}
+    }
+}
+{{end}}
+{{- end}}
+{{define "cadence"}}
+{{- if .NetworkCadence -}}
+/// The template Cadence with imports replaced by the contract addresses of each network
+    static let networkCadence: [String: String] = [
+{{- range .NetworkCadence}}
+        "{{.Network}}": {{swiftString .Cadence}},
+{{- end}}
+    ]
+
+    /// Returns the template Cadence of the network
+    public static func code(network: String) throws -> String {
+        guard let code = networkCadence[network] else {
+            throw Error.networkNotFound(network)
+        }
+        return code
+    }
+{{- else -}}
+/// The template Cadence, it has no dependencies and is the same on every network
+    static let templateCadence = {{swiftString .Cadence}}
+
+    /// Returns the template Cadence of the network
+    public static func code(network: String) throws -> String {
+        templateCadence
+    }
+{{- end}}
+{{- end}}
+`
+
+	return template
+}
@@ -0,0 +1,18 @@
+package templates
+
+func GetSwiftScriptTemplate() string {
+	const template = `{{define "script"}}    /// Executes the template script on the network
+    public static func query(network: String{{if len .Parameters}}, params: {{.ParametersPrefixName}}Params{{end}}) async throws -> Flow.ScriptResponse {
+        let script = try Self.code(network: network)
+        return try await flow.query {
+            cadence { script }
+{{- if len .Parameters}}
+            arguments { Self.encode(params) }
+{{- end}}
+        }
+    }
+{{- end}}
+`
+
+	return template
+}
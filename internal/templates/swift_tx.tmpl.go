@@ -0,0 +1,25 @@
+package templates
+
+func GetSwiftTxTemplate() string {
+	const template = `{{define "tx"}}    /// Sends the template transaction on the network with the signer as proposer, payer{{if .Authorizers}} and authorizer{{end}}
+    public static func send(network: String{{if len .Parameters}}, params: {{.ParametersPrefixName}}Params{{end}}, signer: FlowSigner) async throws -> Flow.ID {
+        let script = try Self.code(network: network)
+        return try await flow.sendTransaction(signers: [signer]) {
+            cadence { script }
+            proposer { Flow.TransactionProposalKey(address: signer.address, keyIndex: signer.keyIndex) }
+            payer { signer.address }
+{{- if eq .Authorizers 1}}
+            authorizers { signer.address }
+{{- else if .Authorizers}}
+            authorizers { Array(repeating: signer.address, count: {{.Authorizers}}) }
+{{- end}}
+{{- if len .Parameters}}
+            arguments { Self.encode(params) }
+{{- end}}
+        }
+    }
+{{- end}}
+`
+
+	return template
+}
@@ -3,13 +3,38 @@ package templates
 func GetTsFclInterfaceTemplate() string {
 	const template = `{{ define "interface" }}
 {{- if len .Parameters -}}
-interface {{ .ParametersPrefixName }}Params {
+{{ if .ExportTypes }}export {{ end }}interface {{ .ParametersPrefixName }}Params {
 {{- range .Parameters }}
   {{ .Name }}: {{ .JsType }}; {{- if .Description }} // {{ .Description }} {{- end }}
 {{- end }}
 }
 {{ end }}
 {{ end }}
+
+{{ define "outputInterfaces" }}
+{{- range .OutputInterfaces -}}
+{{ if $.ExportTypes }}export {{ end }}interface {{ .Name }} {
+{{- range .Fields }}
+  {{ .Name }}: {{ .JsType }};
+{{- end }}
+}
+
+{{ end }}
+{{- if .TxResults -}}
+{{ if $.ExportTypes }}export {{ end }}interface {{ .ParametersPrefixName }}Result {
+  transactionId: string;
+  blockId: string;
+  statusCode: number;
+  errorMessage: string;
+  events: {
+{{- range .Events }}
+    {{ .Key }}: Array<{{ .Interface }}>;
+{{- end }}
+  };
+}
+
+{{ end }}
+{{- end }}
 `
 
 	return template
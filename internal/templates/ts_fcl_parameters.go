@@ -11,6 +11,116 @@ func GetTsFclParamsTemplate() string {
 {{- end -}}
 {{ end }}
 	
+{{define "paramNames"}}
+{{- if len .Parameters -}}
+{ {{range $index, $ele := .Parameters}}{{if $index}}, {{end}}{{.Name}}{{end}} }
+{{- end -}}
+{{end}}
+{{define "validate"}}
+{{- range .Parameters}}{{if .JsValidate}}
+  {{.JsValidate}};
+{{- end}}{{end}}
+{{- end}}
+{{define "validators"}}
+{{- if len . -}}
+type Validator = (label: string, value: any) => void;
+
+function describeValue(value: any): string {
+  return typeof value === "bigint" ? value.toString() : JSON.stringify(value);
+}
+{{- if index . "validateAddress"}}
+
+function validateAddress(label: string, value: any): void {
+  if (typeof value !== "string" || !/^(0x)?[0-9a-fA-F]{16}$/.test(value)) {
+    throw new Error(label + ": expected an Address of 16 hex characters such as 0xf8d6e0586b0a20c7, got " + describeValue(value));
+  }
+}
+{{- end}}
+{{- if index . "validateString"}}
+
+function validateString(label: string, value: any): void {
+  if (typeof value !== "string") {
+    throw new Error(label + ": expected a string, got " + describeValue(value));
+  }
+}
+{{- end}}
+{{- if index . "validateBool"}}
+
+function validateBool(label: string, value: any): void {
+  if (typeof value !== "boolean") {
+    throw new Error(label + ": expected a boolean, got " + describeValue(value));
+  }
+}
+{{- end}}
+{{- if index . "validateFixed"}}
+
+function validateFixed(label: string, value: any, type: string): void {
+  const signed = type === "Fix64";
+  if (typeof value !== "string" || !(signed ? /^-?\d+\.\d{1,8}$/ : /^\d+\.\d{1,8}$/).test(value)) {
+    throw new Error(label + ": expected a " + type + " string with a decimal point and at most 8 decimal places such as 1.0, got " + describeValue(value));
+  }
+  const [integer, fraction] = value.split(".");
+  const scaled = BigInt(integer + fraction.padEnd(8, "0"));
+  const min = BigInt(signed ? "-9223372036854775808" : "0");
+  const max = BigInt(signed ? "9223372036854775807" : "18446744073709551615");
+  if (scaled < min || scaled > max) {
+    throw new Error(label + ": " + value + " is out of the range of " + type);
+  }
+}
+{{- end}}
+{{- if index . "validateInt"}}
+
+function validateInt(label: string, value: any, type: string, min: string | null, max: string | null): void {
+  const text = typeof value === "string" || typeof value === "number" || typeof value === "bigint" ? String(value) : "";
+  if (!/^-?\d+$/.test(text)) {
+    throw new Error(label + ": expected an integer " + type + " such as 1, got " + describeValue(value));
+  }
+  if ((min !== null && BigInt(text) < BigInt(min)) || (max !== null && BigInt(text) > BigInt(max))) {
+    throw new Error(label + ": " + text + " is out of the range of " + type);
+  }
+}
+{{- end}}
+{{- if index . "validateOptional"}}
+
+function validateOptional(label: string, value: any, validate: Validator): void {
+  if (value != null) {
+    validate(label, value);
+  }
+}
+{{- end}}
+{{- if index . "validateArray"}}
+
+function validateArray(label: string, value: any, length: number | null, validate: Validator | null): void {
+  if (!Array.isArray(value)) {
+    throw new Error(label + ": expected an array, got " + describeValue(value));
+  }
+  if (length !== null && value.length !== length) {
+    throw new Error(label + ": expected " + length + " elements, got " + value.length);
+  }
+  if (validate) {
+    value.forEach((v: any, i: number) => validate(label + "[" + i + "]", v));
+  }
+}
+{{- end}}
+{{- if index . "validateDictionary"}}
+
+function validateDictionary(label: string, value: any, validateKey: Validator | null, validateValue: Validator | null): void {
+  if (typeof value !== "object" || value === null || Array.isArray(value)) {
+    throw new Error(label + ": expected an object, got " + describeValue(value));
+  }
+  for (const [k, v] of Object.entries(value)) {
+    if (validateKey) {
+      validateKey(label + " key " + k, k);
+    }
+    if (validateValue) {
+      validateValue(label + "[" + k + "]", v);
+    }
+  }
+}
+{{- end}}
+
+{{end}}
+{{- end}}
 `
 
 	return template
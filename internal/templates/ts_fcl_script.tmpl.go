@@ -3,19 +3,20 @@ package templates
 func GetTsFclScriptTemplate() string {
 	const template = `{{define "script"}}export async function {{.Title}}( 
 {{- template "params" .}}): Promise<{{.Output.JsType}}> {
+{{- template "validate" .}}
   const info = await fcl.query({
     cadence: "",
-    template: flixTemplate,
+    template: {{.TemplateVar}},
     {{ if len .Parameters -}}
     args: (arg, t) => [
       {{- range $index, $ele := .Parameters -}}
-        {{if $index}}, {{end}}arg({{.Name}}, t.{{.FclType}})
+        {{if $index}}, {{end}}arg({{.JsArg}}, t.{{.FclType}})
       {{- end -}}
       ]
     {{- end }}
   });
 
-  return info
+  return {{.OutputDecode}}
 }{{end}}
 `
 
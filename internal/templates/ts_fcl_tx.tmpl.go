@@ -3,19 +3,44 @@ package templates
 func GetTsFclTxTemplate() string {
 	const template = `{{define "tx"}}export async function {{.Title}}(
   {{- template "params" .}}): Promise<string> {
+{{- template "validate" .}}
   const transactionId = await fcl.mutate({
-    template: flixTemplate,
+    template: {{.TemplateVar}},
     {{ if len .Parameters -}}
     args: (arg, t) => [
       {{- range $index, $ele := .Parameters -}}
-        {{if $index}}, {{end}}arg({{.Name}}, t.{{.FclType}})
+        {{if $index}}, {{end}}arg({{.JsArg}}, t.{{.FclType}})
       {{- end -}}
       ]
     {{- end }}
   });
 
   return transactionId
-}{{end}}
+}
+{{- if .TxResults}}
+
+/**
+* {{.Title}}Sealed: sends the transaction and waits for it to be sealed
+* @returns {Promise<{{.ParametersPrefixName}}Result>} - Returns a promise that resolves to the transaction result with its decoded events
+*/
+export async function {{.Title}}Sealed(
+  {{- template "params" .}}): Promise<{{.ParametersPrefixName}}Result> {
+  const transactionId = await {{.Title}}({{template "paramNames" .}});
+  const status = await fcl.tx(transactionId).onceSealed();
+
+  return {
+    transactionId,
+    blockId: status.blockId,
+    statusCode: status.statusCode,
+    errorMessage: status.errorMessage,
+    events: {
+      {{- range .Events}}
+      {{.Key}}: status.events.filter((e: any) => e.type.endsWith(".{{.Type}}")).map((e: any) => {{.Decode}}),
+      {{- end}}
+    },
+  };
+}
+{{- end}}{{end}}
 `
 
 	return template
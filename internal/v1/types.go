@@ -4,6 +4,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"slices"
+	"sort"
+
+	"github.com/onflow/flow-go-sdk"
+
+	"github.com/onflow/flixkit-go/v2/internal/common"
 )
 
 type Network struct {
@@ -74,12 +80,66 @@ func ParseFlix(template string) (*FlowInteractionTemplate, error) {
 	return &flowTemplate, nil
 }
 
+var importRegex = regexp.MustCompile(`import\s*(\w+)\s*from\s*(0x\w+)`)
+
+// Networks returns the sorted names of all networks in dependencies
+func (t *FlowInteractionTemplate) Networks() []string {
+	seen := make(map[string]bool)
+	var networks []string
+	for _, contracts := range t.Data.Dependencies {
+		for _, nets := range contracts {
+			for networkName := range nets {
+				if !seen[networkName] {
+					seen[networkName] = true
+					networks = append(networks, networkName)
+				}
+			}
+		}
+	}
+	sort.Strings(networks)
+	return networks
+}
+
+// MissingContracts returns the imported contracts that have no address on the network
+func (t *FlowInteractionTemplate) MissingContracts(networkName string) []string {
+	var missing []string
+	for _, match := range importRegex.FindAllStringSubmatch(t.Data.Cadence, -1) {
+		contractName, dependencyAddress := match[1], match[2]
+		network, ok := t.Data.Dependencies[dependencyAddress][contractName][networkName]
+		if (!ok || network.Address == "") && !slices.Contains(missing, contractName) {
+			missing = append(missing, contractName)
+		}
+	}
+	return missing
+}
+
+// MissingDependencyPins returns the dependency contracts on the network that have no pin, sorted by name
+func (t *FlowInteractionTemplate) MissingDependencyPins(networkName string) []string {
+	var missing []string
+	for _, contracts := range t.Data.Dependencies {
+		for contractName, nets := range contracts {
+			if network, ok := nets[networkName]; ok && network.Pin == "" {
+				missing = append(missing, contractName)
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
 func (t *FlowInteractionTemplate) ReplaceCadenceImports(networkName string) (string, error) {
+	cadence, _, err := t.ReplaceCadenceImportsWithOptions(networkName, common.ResolveOptions{})
+	return cadence, err
+}
+
+// ReplaceCadenceImportsWithOptions replaces imports with addresses of the network after applying
+// network aliases and contract overrides, the overrides that were used are returned
+func (t *FlowInteractionTemplate) ReplaceCadenceImportsWithOptions(networkName string, opts common.ResolveOptions) (string, []common.AppliedOverride, error) {
 	var cadence = t.Data.Cadence
+	var applied []common.AppliedOverride
+	resolvedNetwork := opts.ResolveNetwork(networkName)
 
-	// Compile regular expression to match imports
-	re := regexp.MustCompile(`import\s*(\w+)\s*from\s*(0x\w+)`)
-	matches := re.FindAllStringSubmatch(cadence, -1)
+	matches := importRegex.FindAllStringSubmatch(cadence, -1)
 
 	for _, match := range matches {
 		if len(match) != 3 {
@@ -88,35 +148,50 @@ func (t *FlowInteractionTemplate) ReplaceCadenceImports(networkName string) (str
 		contractName := match[1]
 		dependencyAddress := match[2]
 
-		// Check if dependency exists
-		contracts, ok := t.Data.Dependencies[dependencyAddress]
-		if !ok {
-			return "", fmt.Errorf("network %s not found for contract %s in dependencies", networkName, contractName)
-		}
-
-		// Check if contract exists in dependency
-		networks, ok := contracts[contractName]
-		if !ok {
-			return "", fmt.Errorf("contract %s not found in dependencies", contractName)
+		address, err := t.dependencyAddress(contractName, dependencyAddress, resolvedNetwork)
+		if override, ok := opts.ContractOverrides[contractName]; ok {
+			if !slices.ContainsFunc(applied, func(a common.AppliedOverride) bool { return a.Contract == contractName }) {
+				applied = append(applied, common.NewAppliedOverride(contractName, override, address))
+			}
+			address, err = flow.HexToAddress(override).HexWithPrefix(), nil
 		}
-
-		// Check if network exists for contract
-		network, ok := networks[networkName]
-		if !ok {
-			return "", fmt.Errorf("network %s not found for contract %s in dependencies", networkName, contractName)
+		if err != nil {
+			return "", nil, err
 		}
 
 		pattern := fmt.Sprintf(`import\s*%s\s*from\s*%s`, contractName, dependencyAddress)
 		re, err := regexp.Compile(pattern)
 		if err != nil {
-			return "", fmt.Errorf("invalid regex pattern: %v", err)
+			return "", nil, fmt.Errorf("invalid regex pattern: %v", err)
 		}
 
-		replacement := fmt.Sprintf("import %s from %s", contractName, network.Address)
+		replacement := fmt.Sprintf("import %s from %s", contractName, address)
 		cadence = re.ReplaceAllString(cadence, replacement)
 	}
 
-	return cadence, nil
+	return cadence, applied, nil
+}
+
+// dependencyAddress returns the network address of a contract imported from a placeholder address
+func (t *FlowInteractionTemplate) dependencyAddress(contractName string, dependencyAddress string, networkName string) (string, error) {
+	// Check if dependency exists
+	contracts, ok := t.Data.Dependencies[dependencyAddress]
+	if !ok {
+		return "", fmt.Errorf("network %s not found for contract %s in dependencies", networkName, contractName)
+	}
+
+	// Check if contract exists in dependency
+	networks, ok := contracts[contractName]
+	if !ok {
+		return "", fmt.Errorf("contract %s not found in dependencies", contractName)
+	}
+
+	// Check if network exists for contract
+	network, ok := networks[networkName]
+	if !ok {
+		return "", fmt.Errorf("network %s not found for contract %s in dependencies", networkName, contractName)
+	}
+	return network.Address, nil
 }
 
 func (t *FlowInteractionTemplate) GetDescription() string {
@@ -145,3 +220,27 @@ func (msgs *Messages) GetTitleValue(placeholder string) string {
 	}
 	return s
 }
+
+func (msgs *Messages) GetDescriptionValue(placeholder string) string {
+	s := placeholder
+	if msgs.Description != nil &&
+		msgs.Description.I18N != nil {
+		// relying on en-US for now, future we need to know what language to use
+		value, exists := msgs.Description.I18N["en-US"]
+		if exists {
+			s = value
+		}
+	}
+	return s
+}
+
+// ResolveContracts returns the address of each contract the cadence imports on the network
+func (t *FlowInteractionTemplate) ResolveContracts(networkName string) map[string]string {
+	contracts := make(map[string]string)
+	for _, match := range importRegex.FindAllStringSubmatch(t.Data.Cadence, -1) {
+		if address, err := t.dependencyAddress(match[1], match[2], networkName); err == nil {
+			contracts[match[1]] = flow.HexToAddress(address).HexWithPrefix()
+		}
+	}
+	return contracts
+}
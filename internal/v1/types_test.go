@@ -92,3 +92,25 @@ func TestReplaceCadenceImports(t *testing.T) {
 		})
 	}
 }
+
+func TestResolveContracts(t *testing.T) {
+	template := &FlowInteractionTemplate{
+		Data: Data{
+			Cadence: "import FungibleToken from 0xFUNGIBLETOKENADDRESS",
+			Dependencies: Dependencies{
+				"0xFUNGIBLETOKENADDRESS": Contracts{
+					"FungibleToken": Networks{
+						"testnet": Network{Address: "9a0766d93b6608b7"},
+					},
+				},
+				"0xFLOWTOKENADDRESS": Contracts{
+					"FlowToken": Networks{
+						"testnet": Network{Address: "0x7e60df042a9c0868"},
+					},
+				},
+			},
+		},
+	}
+	assert.Equal(t, map[string]string{"FungibleToken": "0x9a0766d93b6608b7"}, template.ResolveContracts("testnet"))
+	assert.Empty(t, template.ResolveContracts("mainnet"))
+}
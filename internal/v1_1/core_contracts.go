@@ -0,0 +1,124 @@
+package v1_1
+
+/*
+Addresses of core contracts, used when an import is not in the user supplied contract infos
+https://developers.flow.com/build/core-contracts
+*/
+var coreContracts = ContractInfos{
+	"FungibleToken": {
+		"mainnet":  "0xf233dcee88fe0abe",
+		"testnet":  "0x9a0766d93b6608b7",
+		"emulator": "0xee82856bf20e2aa6",
+	},
+	"FungibleTokenMetadataViews": {
+		"mainnet":  "0xf233dcee88fe0abe",
+		"testnet":  "0x9a0766d93b6608b7",
+		"emulator": "0xee82856bf20e2aa6",
+	},
+	"FungibleTokenSwitchboard": {
+		"mainnet":  "0xf233dcee88fe0abe",
+		"testnet":  "0x9a0766d93b6608b7",
+		"emulator": "0xee82856bf20e2aa6",
+	},
+	"Burner": {
+		"mainnet":  "0xf233dcee88fe0abe",
+		"testnet":  "0x9a0766d93b6608b7",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowToken": {
+		"mainnet":  "0x1654653399040a61",
+		"testnet":  "0x7e60df042a9c0868",
+		"emulator": "0x0ae53cb6e3f42a79",
+	},
+	"FlowFees": {
+		"mainnet":  "0xf919ee77447b7497",
+		"testnet":  "0x912d5440f7e3769e",
+		"emulator": "0xe5a8b7f23e8b548f",
+	},
+	"FlowServiceAccount": {
+		"mainnet":  "0xe467b9dd11fa00df",
+		"testnet":  "0x8c5303eaa26202d6",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowStorageFees": {
+		"mainnet":  "0xe467b9dd11fa00df",
+		"testnet":  "0x8c5303eaa26202d6",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"RandomBeaconHistory": {
+		"mainnet":  "0xe467b9dd11fa00df",
+		"testnet":  "0x8c5303eaa26202d6",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"NodeVersionBeacon": {
+		"mainnet":  "0xe467b9dd11fa00df",
+		"testnet":  "0x8c5303eaa26202d6",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"EVM": {
+		"mainnet":  "0xe467b9dd11fa00df",
+		"testnet":  "0x8c5303eaa26202d6",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowIDTableStaking": {
+		"mainnet":  "0x8624b52f9ddcd04a",
+		"testnet":  "0x9eca2b38b18b5dfe",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowEpoch": {
+		"mainnet":  "0x8624b52f9ddcd04a",
+		"testnet":  "0x9eca2b38b18b5dfe",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowClusterQC": {
+		"mainnet":  "0x8624b52f9ddcd04a",
+		"testnet":  "0x9eca2b38b18b5dfe",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"FlowDKG": {
+		"mainnet":  "0x8624b52f9ddcd04a",
+		"testnet":  "0x9eca2b38b18b5dfe",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"LockedTokens": {
+		"mainnet":  "0x8d0e87b65159ae63",
+		"testnet":  "0x95e019a17d0e23d7",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"NonFungibleToken": {
+		"mainnet":  "0x1d7e57aa55817448",
+		"testnet":  "0x631e88ae7f1d7c20",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"MetadataViews": {
+		"mainnet":  "0x1d7e57aa55817448",
+		"testnet":  "0x631e88ae7f1d7c20",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+	"ViewResolver": {
+		"mainnet":  "0x1d7e57aa55817448",
+		"testnet":  "0x631e88ae7f1d7c20",
+		"emulator": "0xf8d6e0586b0a20c7",
+	},
+}
+
+// CoreContracts returns a copy of the built-in core contract registry
+func CoreContracts() ContractInfos {
+	return MergeContractInfos(coreContracts, nil)
+}
+
+// MergeContractInfos returns a new ContractInfos with the overrides applied on top of base per contract and network
+func MergeContractInfos(base ContractInfos, overrides ContractInfos) ContractInfos {
+	merged := make(ContractInfos)
+	for _, infos := range []ContractInfos{base, overrides} {
+		for contract, networks := range infos {
+			if merged[contract] == nil {
+				merged[contract] = make(NetworkAddressMap)
+			}
+			for network, address := range networks {
+				merged[contract][network] = address
+			}
+		}
+	}
+	return merged
+}
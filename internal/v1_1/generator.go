@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/onflow/cadence/ast"
@@ -26,26 +28,51 @@ Same structure as core contracts, keyed by contract name
 */
 type ContractInfos map[string]NetworkAddressMap
 
+/*
+Subset of the access api used to pin dependencies, satisfied by grpc.Client
+*/
+type flowClient interface {
+	GetNetworkParameters(ctx context.Context) (*flow.NetworkParameters, error)
+	GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error)
+	GetAccountAtBlockHeight(ctx context.Context, address flow.Address, blockHeight uint64) (*flow.Account, error)
+}
+
+/*
+Access client along with the block height dependencies are pinned at,
+zero pin block height uses the latest sealed block
+*/
+type networkClient struct {
+	flowClient
+	pinBlockHeight uint64
+	// dependency pins shared across templates, nil unless ShareDependencyPins was called
+	pins map[string]PinDetail
+}
+
 type Generator struct {
 	deployedContracts []Contract
-	clients           []*grpc.Client
+	coreContracts     []Contract
+	clients           []networkClient
 	template          *InteractionTemplate
 }
 
 func NewTemplateGenerator(contractInfos ContractInfos, logger common.Logger, networks []common.NetworkConfig) (*Generator, error) {
-	var clients []*grpc.Client
+	var clients []networkClient
 	for _, network := range networks {
 		client, err := grpc.NewClient(network.Host)
 		if err != nil {
 			return nil, fmt.Errorf("could not create client for %s: %w", network.Name, err)
 		}
-		clients = append(clients, client)
+		clients = append(clients, networkClient{
+			flowClient:     client,
+			pinBlockHeight: network.PinBlockHeight,
+		})
 	}
 
 	deployedContracts := contractInfosToContracts(contractInfos)
 
 	return &Generator{
 		deployedContracts: deployedContracts,
+		coreContracts:     contractInfosToContracts(coreContracts),
 		clients:           clients,
 		template:          &InteractionTemplate{},
 	}, nil
@@ -91,6 +118,12 @@ func (g Generator) CreateTemplate(ctx context.Context, code string, preFill stri
 
 	// need to process dependencies before calculating network pins
 	_ = g.calculateNetworkPins()
+	// ordering feeds into the id, keep generated content canonical so identical input gives identical output,
+	// messages of a pre-filled template keep the order they were written in
+	g.template.SortDependencies()
+	if preFill == "" {
+		g.template.SortMessages()
+	}
 	id, _ := GenerateFlixID(g.template)
 	g.template.ID = id
 	templateJson, err := json.MarshalIndent(g.template, "", "    ")
@@ -99,6 +132,136 @@ func (g Generator) CreateTemplate(ctx context.Context, code string, preFill stri
 
 }
 
+/*
+Dependency pin that moved while refreshing a template
+*/
+type DependencyPinChange struct {
+	Contract       string
+	Network        string
+	OldPin         string
+	NewPin         string
+	OldBlockHeight uint64
+	NewBlockHeight uint64
+}
+
+/*
+Summary of a template refresh, pins that moved along with the old and new template id
+*/
+type RefreshSummary struct {
+	OldID                string
+	NewID                string
+	DependencyPinChanges []DependencyPinChange
+}
+
+func (s RefreshSummary) Changed() bool {
+	return s.OldID != s.NewID || len(s.DependencyPinChanges) > 0
+}
+
+// RefreshTemplate re-pins dependencies of an existing template whose on-chain code changed,
+// recomputes network pins and id and adds network pins of networks the dependencies resolve on,
+// all other template content is kept as is and in its order
+func (g Generator) RefreshTemplate(ctx context.Context, flix string) (string, *RefreshSummary, error) {
+	template, err := ParseFlix(flix)
+	if err != nil {
+		return "", nil, err
+	}
+	if template.FVersion != "1.1.0" {
+		return "", nil, fmt.Errorf("flix template version: %s not supported", template.FVersion)
+	}
+	summary := &RefreshSummary{OldID: template.ID}
+
+	for _, dep := range template.Data.Dependencies {
+		for _, contract := range dep.Contracts {
+			for i := range contract.Networks {
+				network := &contract.Networks[i]
+				c := getNetworkClient(network.Network, g.clients)
+				if c == nil {
+					continue
+				}
+				height, err := c.pinHeight(ctx)
+				if err != nil {
+					return "", nil, fmt.Errorf("could not determine pin block height for %s on %s: %w", contract.Contract, network.Network, err)
+				}
+				details, err := g.GenerateDepPinDepthFirst(ctx, c, network.Address, contract.Contract, height)
+				if err != nil {
+					return "", nil, err
+				}
+				if samePinDetail(network.DependencyPin, details) {
+					continue
+				}
+				change := DependencyPinChange{
+					Contract:       contract.Contract,
+					Network:        network.Network,
+					NewPin:         details.Pin,
+					OldBlockHeight: network.DependencyPinBlockHeight,
+					NewBlockHeight: height,
+				}
+				if network.DependencyPin != nil {
+					change.OldPin = network.DependencyPin.Pin
+				}
+				summary.DependencyPinChanges = append(summary.DependencyPinChanges, change)
+				network.DependencyPinBlockHeight = height
+				network.DependencyPin = details
+			}
+		}
+	}
+
+	// network pins only depend on the body and addresses, recompute the ones already present
+	for i, pin := range template.Data.Cadence.NetworkPins {
+		cad, err := template.ReplaceCadenceImports(pin.Network)
+		if err != nil {
+			return "", nil, err
+		}
+		template.Data.Cadence.NetworkPins[i].PinSelf = ShaHex(cad, "")
+	}
+	// and add pins of networks the dependencies resolve on now, existing content keeps its order
+	for _, network := range template.Networks() {
+		if template.NetworkPin(network) != "" {
+			continue
+		}
+		cad, err := template.ReplaceCadenceImports(network)
+		if err != nil {
+			continue
+		}
+		template.Data.Cadence.NetworkPins = append(template.Data.Cadence.NetworkPins, NetworkPin{
+			Network: network,
+			PinSelf: ShaHex(cad, ""),
+		})
+	}
+
+	id, err := GenerateFlixID(template)
+	if err != nil {
+		return "", nil, err
+	}
+	template.ID = id
+	summary.NewID = id
+	templateJson, err := json.MarshalIndent(template, "", "    ")
+	if err != nil {
+		return "", nil, err
+	}
+
+	return string(templateJson), summary, nil
+}
+
+// samePinDetail compares the contract code hashes of two pin trees, block heights are ignored
+func samePinDetail(a *PinDetail, b *PinDetail) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if a.PinSelf != b.PinSelf ||
+		a.PinContractName != b.PinContractName ||
+		a.PinContractAddress != b.PinContractAddress ||
+		len(a.Imports) != len(b.Imports) {
+		return false
+	}
+	for i := range a.Imports {
+		if !samePinDetail(&a.Imports[i], &b.Imports[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func (g Generator) calculateNetworkPins() error {
 	networkPins := make([]NetworkPin, 0)
 	// only interested in the client networks
@@ -133,14 +296,16 @@ func (g Generator) processDependencies(ctx context.Context, program *ast.Program
 	// fill in dependence information
 	g.template.Data.Dependencies = make([]Dependency, 0)
 	for _, imp := range imports {
-
-		// Built-in contracts imports are represented with identifier location
-		_, isBuiltInContract := imp.Location.(cadenceCommon.IdentifierLocation)
-		if isBuiltInContract {
+		cadenceImport := ClassifyImportDeclaration(imp)
+		switch cadenceImport.Kind {
+		case BuiltInImport, IdentifierImport:
+			// provided by the runtime, not a dependency
 			continue
+		case AddressImport:
+			return fmt.Errorf("address import of %s from %s is not supported, use string imports", strings.Join(cadenceImport.Identifiers, ", "), cadenceImport.Location)
 		}
 
-		contractName := imp.Location.String()
+		contractName := cadenceImport.Location
 		networks, err := g.generateDependenceInfo(ctx, contractName)
 		if err != nil {
 			return err
@@ -158,20 +323,50 @@ func (g Generator) processDependencies(ctx context.Context, program *ast.Program
 	return nil
 }
 
-func getNetworkClient(networkName string, clients []*grpc.Client) *grpc.Client {
-	for _, c := range clients {
+func getNetworkClient(networkName string, clients []networkClient) *networkClient {
+	for i, c := range clients {
 		netParams, err := c.GetNetworkParameters(context.Background())
 		if err != nil {
 			continue
 		}
 		// chain id contains network name like "flow-testnet" contains "testnet"
 		if strings.Contains(netParams.ChainID.String(), networkName) {
-			return c
+			return &clients[i]
+		}
+	}
+	return nil
+}
+
+// ShareDependencyPins pins every network at a single sealed block height and reuses
+// dependency pins across all templates created afterwards, used when creating templates in bulk
+func (g *Generator) ShareDependencyPins(ctx context.Context) error {
+	for i := range g.clients {
+		height, err := g.clients[i].pinHeight(ctx)
+		if err != nil {
+			return err
 		}
+		g.clients[i].pinBlockHeight = height
+		g.clients[i].pins = make(map[string]PinDetail)
 	}
 	return nil
 }
 
+// pinHeight returns the configured pin block height of the network,
+// falling back to the latest sealed block height when none is configured
+func (c *networkClient) pinHeight(ctx context.Context) (uint64, error) {
+	block, err := c.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+	if c.pinBlockHeight == 0 {
+		return block.Height, nil
+	}
+	if c.pinBlockHeight > block.Height {
+		return 0, fmt.Errorf("pin block height %d is above latest sealed block height %d", c.pinBlockHeight, block.Height)
+	}
+	return c.pinBlockHeight, nil
+}
+
 func (g *Generator) generateDependenceInfo(ctx context.Context, contractName string) ([]Network, error) {
 	// only support string import syntax
 	contractNetworks := g.LookupImportContractInfo(contractName)
@@ -186,11 +381,10 @@ func (g *Generator) generateDependenceInfo(ctx context.Context, contractName str
 		}
 		c := getNetworkClient(n.Network, g.clients)
 		if n.DependencyPinBlockHeight == 0 && c != nil {
-			block, err := c.GetLatestBlockHeader(ctx, true)
+			height, err := c.pinHeight(ctx)
 			if err != nil {
-				return nil, err
+				return nil, fmt.Errorf("could not determine pin block height for %s on %s: %w", contractName, n.Network, err)
 			}
-			height := block.Height
 
 			details, err := g.GenerateDepPinDepthFirst(ctx, c, n.Address, contractName, height)
 			if err != nil {
@@ -205,17 +399,29 @@ func (g *Generator) generateDependenceInfo(ctx context.Context, contractName str
 	return networks, nil
 }
 
+// LookupImportContractInfo returns the networks of a user supplied contract, falling back to the core contract registry
 func (g *Generator) LookupImportContractInfo(contractName string) []Network {
-	for _, contract := range g.deployedContracts {
-		if contractName == contract.Contract {
-			return contract.Networks
+	for _, contracts := range [][]Contract{g.deployedContracts, g.coreContracts} {
+		for _, contract := range contracts {
+			if contractName == contract.Contract {
+				return contract.Networks
+			}
 		}
 	}
 	return nil
 }
 
-func (g *Generator) GenerateDepPinDepthFirst(ctx context.Context, clnt *grpc.Client, address string, name string, height uint64) (details *PinDetail, err error) {
+// OverrideCoreContracts applies addresses on top of the built-in core contract registry,
+// contracts not in the registry are added to it
+func (g *Generator) OverrideCoreContracts(overrides ContractInfos) {
+	g.coreContracts = contractInfosToContracts(MergeContractInfos(coreContracts, overrides))
+}
+
+func (g *Generator) GenerateDepPinDepthFirst(ctx context.Context, clnt flowClient, address string, name string, height uint64) (details *PinDetail, err error) {
 	memoize := make(map[string]PinDetail)
+	if nc, ok := clnt.(*networkClient); ok && nc.pins != nil {
+		memoize = nc.pins
+	}
 	networkPinDetail, err := generateDependencyNetworks(ctx, clnt, address, name, memoize, height)
 	if err != nil {
 		return nil, err
@@ -224,7 +430,7 @@ func (g *Generator) GenerateDepPinDepthFirst(ctx context.Context, clnt *grpc.Cli
 	return networkPinDetail, nil
 }
 
-func generateDependencyNetworks(ctx context.Context, c *grpc.Client, address string, name string, cache map[string]PinDetail, height uint64) (*PinDetail, error) {
+func generateDependencyNetworks(ctx context.Context, c flowClient, address string, name string, cache map[string]PinDetail, height uint64) (*PinDetail, error) {
 	addr := flow.HexToAddress(address)
 	identifier := fmt.Sprintf("A.%s.%s", addr.Hex(), name)
 	pinDetail, ok := cache[identifier]
@@ -232,7 +438,8 @@ func generateDependencyNetworks(ctx context.Context, c *grpc.Client, address str
 		return &pinDetail, nil
 	}
 
-	account, err := c.GetAccount(ctx, addr)
+	// contract code is read at the pinned height so pins are reproducible
+	account, err := c.GetAccountAtBlockHeight(ctx, addr, height)
 	if err != nil {
 		return nil, err
 	}
@@ -256,13 +463,13 @@ func generateDependencyNetworks(ctx context.Context, c *grpc.Client, address str
 		}
 		if dep != nil {
 			detailImports = append(detailImports, *dep)
-			cache[identifier] = *dep
 		}
 		pins = append(pins, dep.PinSelf)
 	}
 
 	depend.Imports = detailImports
 	depend.Pin = ShaHex(strings.Join(pins, ""), "")
+	cache[identifier] = depend
 	return &depend, nil
 }
 
@@ -272,27 +479,30 @@ func getAddressImports(code []byte, name string) []string {
 	location := cadenceCommon.StringLocation(name)
 	program, _ := cmd.PrepareProgram(code, location, codes)
 	for _, imp := range program.ImportDeclarations() {
-		address, isAddressImport := imp.Location.(cadenceCommon.AddressLocation)
-		if isAddressImport {
-			adr := address.Address.HexWithPrefix()
-			impName := imp.Imports[0].Identifier.Identifier
-			deps = append(deps, fmt.Sprintf("%s.%s", adr, impName))
+		cadenceImport := ClassifyImportDeclaration(imp)
+		if cadenceImport.Kind != AddressImport {
+			continue
+		}
+		for _, impName := range cadenceImport.Contracts() {
+			deps = append(deps, fmt.Sprintf("%s.%s", cadenceImport.Location, impName))
 		}
 	}
 	return deps
 }
 
-// Add this helper function
 func contractInfosToContracts(infos ContractInfos) []Contract {
 	contracts := make([]Contract, 0)
 
-	for contractName, networks := range infos {
+	// map iteration order is random, walk the keys sorted so the generated template is stable
+	for _, contractName := range slices.Sorted(maps.Keys(infos)) {
+		networks := infos[contractName]
 		contract := Contract{
 			Contract: contractName,
 			Networks: make([]Network, 0),
 		}
 
-		for networkName, address := range networks {
+		for _, networkName := range slices.Sorted(maps.Keys(networks)) {
+			address := networks[networkName]
 			addr := flow.HexToAddress(address)
 			network := Network{
 				Network: networkName,
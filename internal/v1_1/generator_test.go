@@ -3,9 +3,11 @@ package v1_1
 import (
 	"context"
 	_ "embed"
+	"encoding/json"
 	"testing"
 
 	"github.com/hexops/autogold/v2"
+	"github.com/onflow/flow-go-sdk"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -281,3 +283,366 @@ func TestTransferFlowTransaction(t *testing.T) {
 	autogold.ExpectFile(t, template)
 
 }
+
+type fakeFlowClient struct {
+	chainID         flow.ChainID
+	sealedHeight    uint64
+	contracts       map[string][]byte
+	requestedHeight uint64
+}
+
+func (f *fakeFlowClient) GetNetworkParameters(ctx context.Context) (*flow.NetworkParameters, error) {
+	return &flow.NetworkParameters{ChainID: f.chainID}, nil
+}
+
+func (f *fakeFlowClient) GetLatestBlockHeader(ctx context.Context, isSealed bool) (*flow.BlockHeader, error) {
+	return &flow.BlockHeader{Height: f.sealedHeight}, nil
+}
+
+func (f *fakeFlowClient) GetAccountAtBlockHeight(ctx context.Context, address flow.Address, blockHeight uint64) (*flow.Account, error) {
+	f.requestedHeight = blockHeight
+	return &flow.Account{Address: address, Contracts: f.contracts}, nil
+}
+
+func TestPinDependenciesAtBlockHeight(t *testing.T) {
+	contracts := []Contract{
+		{
+			Contract: "HelloWorld",
+			Networks: []Network{
+				{
+					Network: "testnet",
+					Address: "0xee82856bf20e2aa6",
+				},
+			},
+		},
+	}
+	code := `
+	import "HelloWorld"
+
+	access(all)
+	fun main(): String {
+		return HelloWorld.greeting
+	}
+`
+	ctx := context.Background()
+
+	tests := []struct {
+		name           string
+		pinBlockHeight uint64
+		wantHeight     uint64
+		wantErr        bool
+	}{
+		{name: "Latest", pinBlockHeight: 0, wantHeight: 100},
+		{name: "Pinned", pinBlockHeight: 42, wantHeight: 42},
+		{name: "Unsealed", pinBlockHeight: 101, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert := assert.New(t)
+			client := &fakeFlowClient{
+				chainID:      flow.Testnet,
+				sealedHeight: 100,
+				contracts: map[string][]byte{
+					"HelloWorld": []byte(`access(all) contract HelloWorld { access(all) let greeting: String; init() { self.greeting = "Hello" } }`),
+				},
+			}
+			generator := Generator{
+				deployedContracts: contracts,
+				clients:           []networkClient{{flowClient: client, pinBlockHeight: tt.pinBlockHeight}},
+			}
+
+			first, err := generator.CreateTemplate(ctx, code, "")
+			if tt.wantErr {
+				assert.Error(err, "CreateTemplate should return an error for an unsealed height")
+				return
+			}
+			assert.NoError(err, "CreateTemplate should not return an error")
+			assert.Equal(tt.wantHeight, client.requestedHeight, "contract code should be read at the pin height")
+
+			template, err := ParseFlix(first)
+			assert.NoError(err, "ParseFlix should not return an error")
+			network := template.Data.Dependencies[0].Contracts[0].Networks[0]
+			assert.Equal(tt.wantHeight, network.DependencyPinBlockHeight)
+
+			second, err := generator.CreateTemplate(ctx, code, "")
+			assert.NoError(err, "CreateTemplate should not return an error")
+			assert.Equal(first, second, "templates pinned at the same height should be identical")
+		})
+	}
+}
+
+func TestCreateTemplateDeterministic(t *testing.T) {
+	infos := ContractInfos{
+		"FungibleToken": {"mainnet": "0xf233dcee88fe0abe", "testnet": "0x9a0766d93b6608b7", "emulator": "0xee82856bf20e2aa6"},
+		"FlowToken":     {"mainnet": "0x1654653399040a61", "testnet": "0x7e60df042a9c0868", "emulator": "0x0ae53cb6e3f42a79"},
+		"HelloWorld":    {"mainnet": "0x0000000000000001", "testnet": "0x0000000000000002", "emulator": "0x0000000000000003"},
+	}
+	code := `
+	import "FungibleToken"
+	import "FlowToken"
+	import "HelloWorld"
+
+	access(all)
+	fun main(): String {
+		return HelloWorld.greeting
+	}
+`
+	assert := assert.New(t)
+	ctx := context.Background()
+	var first string
+	for i := 0; i < 20; i++ {
+		generator, err := NewTemplateGenerator(infos, nil, nil)
+		assert.NoError(err, "NewTemplateGenerator should not return an error")
+		template, err := generator.CreateTemplate(ctx, code, "")
+		assert.NoError(err, "CreateTemplate should not return an error")
+		if i == 0 {
+			first = template
+			continue
+		}
+		assert.Equal(first, template, "repeated generation should produce identical templates")
+	}
+
+	parsed, err := ParseFlix(first)
+	assert.NoError(err, "ParseFlix should not return an error")
+	networks := parsed.Data.Dependencies[0].Contracts[0].Networks
+	assert.Equal([]string{"emulator", "mainnet", "testnet"}, []string{networks[0].Network, networks[1].Network, networks[2].Network})
+}
+
+func TestRefreshTemplate(t *testing.T) {
+	contracts := []Contract{
+		{
+			Contract: "HelloWorld",
+			Networks: []Network{
+				{Network: "testnet", Address: "0xee82856bf20e2aa6"},
+			},
+		},
+	}
+	client := &fakeFlowClient{
+		chainID:      flow.Testnet,
+		sealedHeight: 100,
+		contracts: map[string][]byte{
+			"HelloWorld": []byte(`access(all) contract HelloWorld { access(all) let greeting: String; init() { self.greeting = "Hello" } }`),
+		},
+	}
+	generator := Generator{
+		deployedContracts: contracts,
+		clients:           []networkClient{{flowClient: client}},
+	}
+	code := `
+	#interaction(
+		version: "1.1.0",
+		title: "Say Hello",
+		description: "Read the greeting from the HelloWorld contract",
+		language: "en-US",
+	)
+
+	import "HelloWorld"
+
+	access(all)
+	fun main(): String {
+		return HelloWorld.greeting
+	}
+`
+	assert := assert.New(t)
+	ctx := context.Background()
+	original, err := generator.CreateTemplate(ctx, code, "")
+	assert.NoError(err, "CreateTemplate should not return an error")
+
+	// code unchanged on chain, nothing should move even though the chain advanced
+	client.sealedHeight = 200
+	refreshed, summary, err := generator.RefreshTemplate(ctx, original)
+	assert.NoError(err, "RefreshTemplate should not return an error")
+	assert.False(summary.Changed(), "refresh without code changes should not change the template")
+	assert.Equal(original, refreshed)
+
+	// contract upgraded on chain, the testnet pin should move
+	client.contracts["HelloWorld"] = []byte(`access(all) contract HelloWorld { access(all) let greeting: String; init() { self.greeting = "Hi" } }`)
+	refreshed, summary, err = generator.RefreshTemplate(ctx, original)
+	assert.NoError(err, "RefreshTemplate should not return an error")
+	assert.True(summary.Changed(), "refresh with code changes should change the template")
+	assert.Len(summary.DependencyPinChanges, 1)
+	change := summary.DependencyPinChanges[0]
+	assert.Equal("HelloWorld", change.Contract)
+	assert.Equal("testnet", change.Network)
+	assert.Equal(uint64(100), change.OldBlockHeight)
+	assert.Equal(uint64(200), change.NewBlockHeight)
+	assert.NotEqual(change.OldPin, change.NewPin)
+	assert.NotEqual(summary.OldID, summary.NewID)
+
+	parsed, err := ParseFlix(refreshed)
+	assert.NoError(err, "ParseFlix should not return an error")
+	assert.Equal(summary.NewID, parsed.ID)
+	var msgs InteractionTemplateMessages = parsed.Data.Messages
+	assert.Equal("Say Hello", msgs.GetTitle(""), "messages should be kept")
+}
+
+func TestRefreshTemplateAddsNetworkPins(t *testing.T) {
+	client := &fakeFlowClient{
+		chainID:      flow.Testnet,
+		sealedHeight: 100,
+		contracts: map[string][]byte{
+			"HelloWorld": []byte(`access(all) contract HelloWorld { access(all) let greeting: String; init() { self.greeting = "Hello" } }`),
+		},
+	}
+	generator := Generator{
+		deployedContracts: []Contract{{
+			Contract: "HelloWorld",
+			Networks: []Network{{Network: "testnet", Address: "0xee82856bf20e2aa6"}},
+		}},
+		clients: []networkClient{{flowClient: client}},
+	}
+	assert := assert.New(t)
+	ctx := context.Background()
+	original, err := generator.CreateTemplate(ctx, "import \"HelloWorld\"\naccess(all) fun main(): String { return HelloWorld.greeting }", "")
+	assert.NoError(err, "CreateTemplate should not return an error")
+
+	// the contract was deployed to mainnet and its address added by hand, messages are written description first
+	template, err := ParseFlix(original)
+	assert.NoError(err, "ParseFlix should not return an error")
+	contract := &template.Data.Dependencies[0].Contracts[0]
+	contract.Networks = append(contract.Networks, Network{Network: "mainnet", Address: "0xe15193734357cf5c"})
+	template.Data.Messages = []Message{
+		{Key: "description", I18n: []I18n{{Tag: "en-US", Translation: "Read the greeting"}}},
+		{Key: "title", I18n: []I18n{{Tag: "en-US", Translation: "Say Hello"}}},
+	}
+	edited, err := json.Marshal(template)
+	assert.NoError(err, "marshal template to json should not return an error")
+
+	refreshed, _, err := generator.RefreshTemplate(ctx, string(edited))
+	assert.NoError(err, "RefreshTemplate should not return an error")
+	parsed, err := ParseFlix(refreshed)
+	assert.NoError(err, "ParseFlix should not return an error")
+	assert.Len(parsed.Data.Cadence.NetworkPins, 2)
+	assert.Equal("testnet", parsed.Data.Cadence.NetworkPins[0].Network)
+	assert.Equal("mainnet", parsed.Data.Cadence.NetworkPins[1].Network)
+	mainnet, err := parsed.ReplaceCadenceImports("mainnet")
+	assert.NoError(err, "ReplaceCadenceImports should not return an error")
+	assert.Equal(ShaHex(mainnet, ""), parsed.NetworkPin("mainnet"))
+	assert.Equal("description", parsed.Data.Messages[0].Key, "refresh should keep the order of messages")
+}
+
+func TestShareDependencyPins(t *testing.T) {
+	contracts := []Contract{
+		{
+			Contract: "FungibleToken",
+			Networks: []Network{{Network: "testnet", Address: "0x9a0766d93b6608b7"}},
+		},
+		{
+			Contract: "FlowToken",
+			Networks: []Network{{Network: "testnet", Address: "0x7e60df042a9c0868"}},
+		},
+	}
+	client := &fakeFlowClient{
+		chainID:      flow.Testnet,
+		sealedHeight: 100,
+		contracts: map[string][]byte{
+			"FungibleToken": []byte(`access(all) contract interface FungibleToken {}`),
+			"FlowToken":     []byte("import FungibleToken from 0x9a0766d93b6608b7\naccess(all) contract FlowToken {}"),
+		},
+	}
+	generator := &Generator{
+		deployedContracts: contracts,
+		clients:           []networkClient{{flowClient: client}},
+	}
+	assert := assert.New(t)
+	ctx := context.Background()
+	assert.NoError(generator.ShareDependencyPins(ctx))
+
+	// the chain moving on should not affect templates of the same batch
+	client.sealedHeight = 200
+	first, err := generator.CreateTemplate(ctx, "import \"FlowToken\"\nimport \"FungibleToken\"\naccess(all) fun main(): Void {}", "")
+	assert.NoError(err, "CreateTemplate should not return an error")
+	second, err := generator.CreateTemplate(ctx, "import \"FungibleToken\"\nimport \"FlowToken\"\naccess(all) fun main(): Void {}", "")
+	assert.NoError(err, "CreateTemplate should not return an error")
+
+	for _, flix := range []string{first, second} {
+		template, err := ParseFlix(flix)
+		assert.NoError(err, "ParseFlix should not return an error")
+		for _, dep := range template.Data.Dependencies {
+			contract := dep.Contracts[0]
+			network := contract.Networks[0]
+			assert.Equal(uint64(100), network.DependencyPinBlockHeight)
+			assert.Equal(contract.Contract, network.DependencyPin.PinContractName, "cached pins should belong to their own contract")
+		}
+	}
+}
+
+func TestCoreContractFallback(t *testing.T) {
+	code := `
+	import "FlowToken"
+	import "HelloWorld"
+
+	access(all) fun main(): Void {}
+`
+	assert := assert.New(t)
+	ctx := context.Background()
+
+	generator, err := NewTemplateGenerator(ContractInfos{
+		"HelloWorld": {"testnet": "0x0000000000000001"},
+	}, nil, nil)
+	assert.NoError(err, "NewTemplateGenerator should not return an error")
+	template, err := generator.CreateTemplate(ctx, code, "")
+	assert.NoError(err, "core contracts should not need to be supplied")
+	flix, err := ParseFlix(template)
+	assert.NoError(err, "ParseFlix should not return an error")
+	_, err = flix.ReplaceCadenceImports("mainnet")
+	assert.Error(err, "HelloWorld is not deployed to mainnet")
+	cadence, err := flix.ReplaceCadenceImports("testnet")
+	assert.NoError(err, "ReplaceCadenceImports should not return an error")
+	assert.Contains(cadence, "import FlowToken from 0x7e60df042a9c0868")
+
+	// user supplied contract infos take precedence over the registry
+	generator, err = NewTemplateGenerator(ContractInfos{
+		"HelloWorld": {"testnet": "0x0000000000000001"},
+		"FlowToken":  {"testnet": "0x0000000000000002"},
+	}, nil, nil)
+	assert.NoError(err, "NewTemplateGenerator should not return an error")
+	assert.Equal([]Network{{Network: "testnet", Address: "0x0000000000000002"}}, generator.LookupImportContractInfo("FlowToken"))
+
+	generator.OverrideCoreContracts(ContractInfos{
+		"FungibleToken": {"emulator": "0x0000000000000003"},
+	})
+	networks := generator.LookupImportContractInfo("FungibleToken")
+	assert.Contains(networks, Network{Network: "emulator", Address: "0x0000000000000003"})
+	assert.Contains(networks, Network{Network: "mainnet", Address: "0xf233dcee88fe0abe"})
+	assert.Nil(generator.LookupImportContractInfo("Unknown"))
+}
+
+func TestCreateTemplateKeepsPreFillOrder(t *testing.T) {
+	preFill := `{
+		"f_type": "InteractionTemplate",
+		"f_version": "1.1.0",
+		"id": "",
+		"data": {
+			"type": "script",
+			"interface": "",
+			"messages": [
+				{"key": "description", "i18n": [{"tag": "fr-FR", "translation": "Lire"}, {"tag": "en-US", "translation": "Read"}]},
+				{"key": "title", "i18n": [{"tag": "en-US", "translation": "Read"}]}
+			],
+			"cadence": {"body": "", "network_pins": []},
+			"dependencies": [],
+			"parameters": []
+		}
+	}`
+	code := "access(all) fun main(): String { return \"Hello\" }"
+	assert := assert.New(t)
+	ctx := context.Background()
+	generator, err := NewTemplateGenerator(ContractInfos{}, nil, nil)
+	assert.NoError(err, "NewTemplateGenerator should not return an error")
+	first, err := generator.CreateTemplate(ctx, code, preFill)
+	assert.NoError(err, "CreateTemplate should not return an error")
+
+	parsed, err := ParseFlix(first)
+	assert.NoError(err, "ParseFlix should not return an error")
+	assert.Equal("description", parsed.Data.Messages[0].Key, "pre-filled messages should keep their order")
+	assert.Equal("fr-FR", parsed.Data.Messages[0].I18n[0].Tag, "pre-filled translations should keep their order")
+
+	// regenerating from the generated template should not change its id
+	second, err := generator.CreateTemplate(ctx, code, first)
+	assert.NoError(err, "CreateTemplate should not return an error")
+	regenerated, err := ParseFlix(second)
+	assert.NoError(err, "ParseFlix should not return an error")
+	assert.Equal(parsed.ID, regenerated.ID)
+}
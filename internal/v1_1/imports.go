@@ -0,0 +1,138 @@
+package v1_1
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	cadenceCommon "github.com/onflow/cadence/common"
+)
+
+type ImportKind int
+
+const (
+	// import "FlowToken" or import FlowToken from "FlowToken"
+	StringImport ImportKind = iota
+	// import FlowToken from 0x1654653399040a61
+	AddressImport
+	// import Foo, identifier imports that are not built in
+	IdentifierImport
+	// import Crypto or import "Crypto", provided by the Cadence runtime
+	BuiltInImport
+)
+
+/*
+Contracts provided by the Cadence runtime, these are never dependencies of a template
+*/
+var builtInContracts = []string{
+	"Crypto",
+}
+
+func IsBuiltInContract(name string) bool {
+	return isItemInArray(name, builtInContracts)
+}
+
+/*
+Import of Cadence code, Location is the contract name for string, identifier and built-in imports
+and the hex address for address imports
+*/
+type CadenceImport struct {
+	Kind        ImportKind
+	Location    string
+	Identifiers []string
+}
+
+// Contracts returns the names of the imported contracts
+func (i CadenceImport) Contracts() []string {
+	if i.Kind == AddressImport {
+		return i.Identifiers
+	}
+	return []string{i.Location}
+}
+
+func classifyImport(location string, identifiers []string, isString bool, isAddress bool) CadenceImport {
+	imp := CadenceImport{
+		Location:    location,
+		Identifiers: identifiers,
+	}
+	if len(imp.Identifiers) == 0 {
+		imp.Identifiers = []string{location}
+	}
+	switch {
+	case isAddress:
+		imp.Kind = AddressImport
+	case IsBuiltInContract(location):
+		imp.Kind = BuiltInImport
+	case isString:
+		imp.Kind = StringImport
+	default:
+		imp.Kind = IdentifierImport
+	}
+	return imp
+}
+
+// ClassifyImportDeclaration classifies a parsed import declaration
+func ClassifyImportDeclaration(imp *ast.ImportDeclaration) CadenceImport {
+	var identifiers []string
+	for _, i := range imp.Imports {
+		identifiers = append(identifiers, i.Identifier.Identifier)
+	}
+	switch location := imp.Location.(type) {
+	case cadenceCommon.AddressLocation:
+		return classifyImport(location.Address.HexWithPrefix(), identifiers, false, true)
+	case cadenceCommon.StringLocation:
+		return classifyImport(string(location), identifiers, true, false)
+	default:
+		return classifyImport(location.String(), identifiers, false, false)
+	}
+}
+
+var importPattern = regexp.MustCompile(`\bimport(?:\s*"([^"\s]+)"|\s+(\w+(?:\s*,\s*\w+)*)\s+from\s*(?:"([^"\s]+)"|(0x\w+))|\s+(\w+))`)
+
+// classifyImportMatch classifies a match of importPattern, groups are
+// quoted location, identifiers, quoted from location, address and identifier location
+func classifyImportMatch(groups []string) CadenceImport {
+	var identifiers []string
+	if groups[2] != "" {
+		for _, identifier := range strings.Split(groups[2], ",") {
+			identifiers = append(identifiers, strings.TrimSpace(identifier))
+		}
+	}
+	switch {
+	case groups[1] != "":
+		return classifyImport(groups[1], identifiers, true, false)
+	case groups[3] != "":
+		return classifyImport(groups[3], identifiers, true, false)
+	case groups[4] != "":
+		return classifyImport(groups[4], identifiers, false, true)
+	default:
+		return classifyImport(groups[5], identifiers, false, false)
+	}
+}
+
+// FindImports classifies the imports of Cadence code without parsing it,
+// so it also works for code the current Cadence parser no longer accepts
+func FindImports(code string) []CadenceImport {
+	var imports []CadenceImport
+	for _, match := range importPattern.FindAllStringSubmatch(code, -1) {
+		imports = append(imports, classifyImportMatch(match))
+	}
+	return imports
+}
+
+// replaceImports rewrites every import of the code with the result of replace
+func replaceImports(code string, replace func(imp CadenceImport, text string) (string, error)) (string, error) {
+	var err error
+	replaced := importPattern.ReplaceAllStringFunc(code, func(text string) string {
+		if err != nil {
+			return text
+		}
+		var r string
+		r, err = replace(classifyImportMatch(importPattern.FindStringSubmatch(text)), text)
+		return r
+	})
+	if err != nil {
+		return "", err
+	}
+	return replaced, nil
+}
@@ -0,0 +1,86 @@
+package v1_1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence/parser"
+	"github.com/stretchr/testify/assert"
+)
+
+const importsCode = `
+import Crypto
+import "Crypto"
+import "FlowToken"
+import Token from "FungibleToken"
+import HelloWorld from 0x0000000000000001
+import Alice, Bob from 0x0000000000000002
+
+access(all) fun main(): Void {}
+`
+
+func TestFindImports(t *testing.T) {
+	assert := assert.New(t)
+
+	imports := FindImports(importsCode)
+	assert.Equal([]CadenceImport{
+		{Kind: BuiltInImport, Location: "Crypto", Identifiers: []string{"Crypto"}},
+		{Kind: BuiltInImport, Location: "Crypto", Identifiers: []string{"Crypto"}},
+		{Kind: StringImport, Location: "FlowToken", Identifiers: []string{"FlowToken"}},
+		{Kind: StringImport, Location: "FungibleToken", Identifiers: []string{"Token"}},
+		{Kind: AddressImport, Location: "0x0000000000000001", Identifiers: []string{"HelloWorld"}},
+		{Kind: AddressImport, Location: "0x0000000000000002", Identifiers: []string{"Alice", "Bob"}},
+	}, imports)
+	assert.Equal([]string{"Alice", "Bob"}, imports[5].Contracts())
+	assert.Equal([]string{"FungibleToken"}, imports[3].Contracts())
+}
+
+func TestClassifyImportDeclaration(t *testing.T) {
+	assert := assert.New(t)
+
+	program, err := parser.ParseProgram(nil, []byte(importsCode), parser.Config{})
+	assert.NoError(err, "ParseProgram should not return an error")
+
+	// parsed and unparsed classification must agree
+	var imports []CadenceImport
+	for _, imp := range program.ImportDeclarations() {
+		imports = append(imports, ClassifyImportDeclaration(imp))
+	}
+	assert.Equal(FindImports(importsCode), imports)
+}
+
+func TestReplaceBuiltInImports(t *testing.T) {
+	assert := assert.New(t)
+
+	template := &InteractionTemplate{
+		Data: Data{
+			Cadence: Cadence{
+				Body: "import Crypto\nimport \"Crypto\"\nimport Token from \"FungibleToken\"\nimport HelloWorld from 0x0000000000000001\n",
+			},
+			Dependencies: []Dependency{
+				{
+					Contracts: []Contract{
+						{
+							Contract: "FungibleToken",
+							Networks: []Network{{Network: "testnet", Address: "0x9a0766d93b6608b7"}},
+						},
+					},
+				},
+			},
+		},
+	}
+	cadence, err := template.ReplaceCadenceImports("testnet")
+	assert.NoError(err, "ReplaceCadenceImports should not return an error")
+	assert.Equal("import Crypto\nimport Crypto\nimport Token from 0x9a0766d93b6608b7\nimport HelloWorld from 0x0000000000000001\n", cadence)
+}
+
+func TestCreateTemplateBuiltInImports(t *testing.T) {
+	assert := assert.New(t)
+
+	generator := Generator{}
+	template, err := generator.CreateTemplate(context.Background(), "import \"Crypto\"\nimport Crypto\naccess(all) fun main(): Void {}", "")
+	assert.NoError(err, "built-in imports should not need contract infos")
+	flix, err := ParseFlix(template)
+	assert.NoError(err, "ParseFlix should not return an error")
+	assert.Empty(flix.Data.Dependencies)
+}
@@ -6,7 +6,6 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"regexp"
 	"sort"
 	"strings"
 
@@ -14,6 +13,8 @@ import (
 	"github.com/onflow/flow-go-sdk"
 	"github.com/onflow/go-ethereum/rlp"
 	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/flixkit-go/v2/internal/common"
 )
 
 type InteractionTemplate struct {
@@ -144,52 +145,200 @@ func (t *InteractionTemplate) IsTransaction() bool {
 	return t.Data.Type == "transaction"
 }
 
-func replaceImport(code string, from string, to string) string {
-	pathRegex := regexp.MustCompile(fmt.Sprintf(`import\s+(\w+)\s+from\s+"%s"`, from))
-	identifierRegex := regexp.MustCompile(fmt.Sprintf(`import\s+"(%s)"`, from))
+// SortContent puts messages, translations, contracts, networks and network pins in canonical order.
+// Dependencies and parameters keep the order of the Cadence code they are derived from.
+func (t *InteractionTemplate) SortContent() {
+	t.SortMessages()
+	t.SortDependencies()
+}
 
-	replacement := fmt.Sprintf(`import $1 from %s`, to)
-	code = pathRegex.ReplaceAllString(code, replacement)
-	code = identifierRegex.ReplaceAllString(code, replacement)
-	return code
+// SortMessages puts messages of the template, its parameters and output and their translations in canonical order
+func (t *InteractionTemplate) SortMessages() {
+	sortMessages(t.Data.Messages)
+	for i := range t.Data.Parameters {
+		sortMessages(t.Data.Parameters[i].Messages)
+	}
+	if t.Data.Output != nil {
+		sortMessages(t.Data.Output.Messages)
+	}
 }
 
-func (t *InteractionTemplate) ReplaceCadenceImports(networkName string) (string, error) {
-	cadence := t.Data.Cadence.Body
-	// Compile regular expression to match and capture contract names
-	re := regexp.MustCompile(`import\s*"([^"]+)"`)
-
-	// Find all matches and their captured groups
-	matches := re.FindAllStringSubmatch(cadence, -1)
-	if len(matches) == 0 {
-		return cadence, nil
-	}
-	for _, match := range matches {
-		contractName := match[1]
-		var dependencyAddress string
-		for _, Dependence := range t.Data.Dependencies {
-			for _, contract := range Dependence.Contracts {
-				if contract.Contract == contractName {
-					for _, network := range contract.Networks {
-						if network.Network == networkName {
-							dependencyAddress = network.Address
-							break
-						}
+// SortDependencies puts contracts, their networks and network pins in canonical order
+func (t *InteractionTemplate) SortDependencies() {
+	for _, dep := range t.Data.Dependencies {
+		sort.SliceStable(dep.Contracts, func(i, j int) bool {
+			return dep.Contracts[i].Contract < dep.Contracts[j].Contract
+		})
+		for _, contract := range dep.Contracts {
+			sort.SliceStable(contract.Networks, func(i, j int) bool {
+				return contract.Networks[i].Network < contract.Networks[j].Network
+			})
+		}
+	}
+	sort.SliceStable(t.Data.Cadence.NetworkPins, func(i, j int) bool {
+		return t.Data.Cadence.NetworkPins[i].Network < t.Data.Cadence.NetworkPins[j].Network
+	})
+}
+
+// title and description lead as in the FLIP examples, other keys follow alphabetically
+func messageKeyRank(key string) string {
+	switch key {
+	case "title":
+		return "0"
+	case "description":
+		return "1"
+	default:
+		return "2" + key
+	}
+}
+
+func sortMessages(msgs []Message) {
+	sort.SliceStable(msgs, func(i, j int) bool {
+		return messageKeyRank(msgs[i].Key) < messageKeyRank(msgs[j].Key)
+	})
+	for _, msg := range msgs {
+		sort.SliceStable(msg.I18n, func(i, j int) bool {
+			return msg.I18n[i].Tag < msg.I18n[j].Tag
+		})
+	}
+}
+
+func (t *InteractionTemplate) dependencyAddress(contractName string, networkName string) string {
+	for _, dependence := range t.Data.Dependencies {
+		for _, contract := range dependence.Contracts {
+			if contract.Contract == contractName {
+				for _, network := range contract.Networks {
+					if network.Network == networkName {
+						return network.Address
 					}
-					break
+				}
+				return ""
+			}
+		}
+	}
+	return ""
+}
+
+// ResolveContracts returns the address of each contract imported by the cadence on the network,
+// contracts without an address on the network are left out
+func (t *InteractionTemplate) ResolveContracts(networkName string) map[string]string {
+	contracts := make(map[string]string)
+	for _, imp := range FindImports(t.Data.Cadence.Body) {
+		if imp.Kind != StringImport {
+			continue
+		}
+		if address := t.dependencyAddress(imp.Location, networkName); address != "" {
+			contracts[imp.Location] = flow.HexToAddress(address).HexWithPrefix()
+		}
+	}
+	return contracts
+}
+
+// Networks returns the sorted names of all networks in dependencies and network pins
+func (t *InteractionTemplate) Networks() []string {
+	var networks []string
+	for _, dependence := range t.Data.Dependencies {
+		for _, contract := range dependence.Contracts {
+			for _, network := range contract.Networks {
+				if !isItemInArray(network.Network, networks) {
+					networks = append(networks, network.Network)
 				}
 			}
 		}
+	}
+	for _, pin := range t.Data.Cadence.NetworkPins {
+		if !isItemInArray(pin.Network, networks) {
+			networks = append(networks, pin.Network)
+		}
+	}
+	sort.Strings(networks)
+	return networks
+}
 
-		if dependencyAddress == "" {
-			return "", fmt.Errorf("network %s not found for contract %s in dependencies", networkName, contractName)
+func (t *InteractionTemplate) NetworkPin(networkName string) string {
+	for _, pin := range t.Data.Cadence.NetworkPins {
+		if pin.Network == networkName {
+			return pin.PinSelf
 		}
-		dAddress := flow.HexToAddress(dependencyAddress)
-		cadence = replaceImport(cadence, contractName, dAddress.HexWithPrefix())
 	}
+	return ""
+}
 
-	return cadence, nil
+// MissingContracts returns the imported contracts that have no address on the network
+func (t *InteractionTemplate) MissingContracts(networkName string) []string {
+	var missing []string
+	for _, imp := range FindImports(t.Data.Cadence.Body) {
+		if imp.Kind != StringImport || isItemInArray(imp.Location, missing) {
+			continue
+		}
+		if t.dependencyAddress(imp.Location, networkName) == "" {
+			missing = append(missing, imp.Location)
+		}
+	}
+	return missing
+}
 
+// MissingDependencyPins returns the dependency contracts on the network that have no dependency pin, sorted by name
+func (t *InteractionTemplate) MissingDependencyPins(networkName string) []string {
+	var missing []string
+	for _, dependence := range t.Data.Dependencies {
+		for _, contract := range dependence.Contracts {
+			for _, network := range contract.Networks {
+				if network.Network == networkName && network.DependencyPin == nil {
+					missing = append(missing, contract.Contract)
+				}
+			}
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func (t *InteractionTemplate) ReplaceCadenceImports(networkName string) (string, error) {
+	cadence, _, err := t.ReplaceCadenceImportsWithOptions(networkName, common.ResolveOptions{})
+	return cadence, err
+}
+
+// ReplaceCadenceImportsWithOptions replaces imports with addresses of the network after applying
+// network aliases and contract overrides, the overrides that were used are returned
+func (t *InteractionTemplate) ReplaceCadenceImportsWithOptions(networkName string, opts common.ResolveOptions) (string, []common.AppliedOverride, error) {
+	resolvedNetwork := opts.ResolveNetwork(networkName)
+	var applied []common.AppliedOverride
+	cadence, err := replaceImports(t.Data.Cadence.Body, func(imp CadenceImport, text string) (string, error) {
+		switch imp.Kind {
+		case BuiltInImport:
+			// built-in contracts are resolved by the runtime using identifier imports
+			return "import " + imp.Location, nil
+		case StringImport:
+			dependencyAddress := t.dependencyAddress(imp.Location, resolvedNetwork)
+			if override, ok := opts.ContractOverrides[imp.Location]; ok {
+				if !appliedOverride(applied, imp.Location) {
+					applied = append(applied, common.NewAppliedOverride(imp.Location, override, dependencyAddress))
+				}
+				dependencyAddress = override
+			}
+			if dependencyAddress == "" {
+				return "", fmt.Errorf("network %s not found for contract %s in dependencies", resolvedNetwork, imp.Location)
+			}
+			dAddress := flow.HexToAddress(dependencyAddress)
+			return fmt.Sprintf("import %s from %s", strings.Join(imp.Identifiers, ", "), dAddress.HexWithPrefix()), nil
+		default:
+			return text, nil
+		}
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return cadence, applied, nil
+}
+
+func appliedOverride(applied []common.AppliedOverride, contractName string) bool {
+	for _, a := range applied {
+		if a.Contract == contractName {
+			return true
+		}
+	}
+	return false
 }
 
 func ParseFlix(template string) (*InteractionTemplate, error) {
@@ -422,10 +571,13 @@ func (template *InteractionTemplate) DetermineCadenceType(program *ast.Program)
 }
 
 func (template *InteractionTemplate) ProcessImports(cadenceCode string) {
-	// Define a regular expression to match the "import ContractName from 0xContractName" pattern
-	pattern := regexp.MustCompile(`import\s+(\w+)\s+from\s+0x\w+`)
-	// Replace the matched pattern with "import \"ContractName\""
-	replaced := pattern.ReplaceAllString(cadenceCode, `import "$1"`)
+	// Replace "import ContractName from 0xContractName" with "import \"ContractName\""
+	replaced, _ := replaceImports(cadenceCode, func(imp CadenceImport, text string) (string, error) {
+		if imp.Kind == AddressImport && len(imp.Identifiers) == 1 {
+			return fmt.Sprintf(`import "%s"`, imp.Identifiers[0]), nil
+		}
+		return text, nil
+	})
 	template.Data.Cadence.Body = replaced
 }
 
@@ -867,3 +867,26 @@ func TestReplaceCadenceImports(t *testing.T) {
 	}
 	assert.Contains(t, cadenceCode, "import HelloWorld from 0xe15193734357cf5c", "Cadence should contain the expected HelloWorld import with address missing leading 0x")
 }
+
+func TestSortContent(t *testing.T) {
+	template := &InteractionTemplate{
+		Data: Data{
+			Messages: []Message{
+				{Key: "description", I18n: []I18n{{Tag: "fr-FR", Translation: "b"}, {Tag: "en-US", Translation: "a"}}},
+				{Key: "notes"},
+				{Key: "title"},
+			},
+			Cadence: Cadence{
+				NetworkPins: []NetworkPin{{Network: "testnet"}, {Network: "mainnet"}},
+			},
+		},
+	}
+	template.SortContent()
+
+	assert := assert.New(t)
+	assert.Equal("title", template.Data.Messages[0].Key)
+	assert.Equal("description", template.Data.Messages[1].Key)
+	assert.Equal("notes", template.Data.Messages[2].Key)
+	assert.Equal("en-US", template.Data.Messages[1].I18n[0].Tag)
+	assert.Equal("mainnet", template.Data.Cadence.NetworkPins[0].Network)
+}